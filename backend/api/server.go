@@ -1,17 +1,24 @@
 package api
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/savour-labs/key-locker/backend/lifecycle"
 	"github.com/savour-labs/key-locker/config"
 	"gorm.io/gorm"
-	"strconv"
 )
 
 type Server struct {
-	db   *gorm.DB
-	echo *echo.Echo
-	port int
+	db              *gorm.DB
+	echo            *echo.Echo
+	port            int
+	shutdownTimeout time.Duration
 }
 
 func NewServer(db *gorm.DB, cfg *config.Server) *Server {
@@ -22,9 +29,10 @@ func NewServer(db *gorm.DB, cfg *config.Server) *Server {
 	e.Use(middleware.Recover())
 	e.Debug = cfg.Debug
 	server := &Server{
-		db:   db,
-		echo: e,
-		port: cfg.Port,
+		db:              db,
+		echo:            e,
+		port:            cfg.Port,
+		shutdownTimeout: time.Duration(cfg.ShutdownTimeout) * time.Second,
 	}
 	server.routes()
 	return server
@@ -35,6 +43,21 @@ func (s *Server) routes() {
 	s.echo.GET("ket/:set", s.SetKeyHandler)
 }
 
-func (s *Server) Run() {
-	s.echo.Logger.Fatal(s.echo.Start(":" + strconv.Itoa(s.port)))
+// Run serves until SIGINT or SIGTERM, then drains the running requests and closes the database pool
+func (s *Server) Run() error {
+	lc := lifecycle.New(s.shutdownTimeout)
+	lc.OnStop("database", func(ctx context.Context) error {
+		sqlDB, err := s.db.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.Close()
+	})
+	lc.OnStop("api server", s.echo.Shutdown)
+	go func() {
+		if err := s.echo.Start(":" + strconv.Itoa(s.port)); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			lc.Fail(err)
+		}
+	}()
+	return lc.Wait()
 }
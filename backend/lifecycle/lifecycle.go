@@ -0,0 +1,116 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const DefaultShutdownTimeout = 30 * time.Second
+
+// LateStopTimeout is the time each hook still to run gets once the shutdown timeout passed
+const LateStopTimeout = 5 * time.Second
+
+type stopHook struct {
+	name string
+	stop func(ctx context.Context) error
+}
+
+// Manager keeps the process running until SIGINT or SIGTERM, or until a service fails,
+// then stops the registered services in the reverse order they were registered
+type Manager struct {
+	timeout time.Duration
+	hooks   []stopHook
+	failed  chan error
+}
+
+func New(timeout time.Duration) *Manager {
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
+	return &Manager{
+		timeout: timeout,
+		failed:  make(chan error, 1),
+	}
+}
+
+// OnStop registers a hook run on shutdown, the hook must return once ctx is done
+func (m *Manager) OnStop(name string, stop func(ctx context.Context) error) {
+	m.hooks = append(m.hooks, stopHook{name: name, stop: stop})
+}
+
+// Fail shuts the process down because a service can not keep running
+func (m *Manager) Fail(err error) {
+	select {
+	case m.failed <- err:
+	default:
+	}
+}
+
+// Wait blocks until a shutdown is triggered and the services are stopped. All hooks together get the shutdown
+// timeout, a hook still running at its deadline is abandoned and the next one runs. The hooks run after the
+// shutdown timeout passed get LateStopTimeout each. The errors of the hooks are returned with the cause.
+func (m *Manager) Wait() error {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sig)
+
+	var cause error
+	select {
+	case s := <-sig:
+		log.Info("received signal, shutting down", "signal", s, "timeout", m.timeout)
+	case cause = <-m.failed:
+		log.Error("service failed, shutting down", "err", cause, "timeout", m.timeout)
+	}
+
+	deadline := time.Now().Add(m.timeout)
+	var failures []string
+	for i := len(m.hooks) - 1; i >= 0; i-- {
+		if err := m.stop(m.hooks[i], deadline); err != nil {
+			log.Error("stop service fail", "service", m.hooks[i].name, "err", err)
+			failures = append(failures, fmt.Sprintf("%s: %v", m.hooks[i].name, err))
+		} else {
+			log.Info("service stopped", "service", m.hooks[i].name)
+		}
+	}
+	switch {
+	case len(failures) == 0:
+		return cause
+	case cause != nil:
+		return fmt.Errorf("%w, stop services fail: %s", cause, strings.Join(failures, "; "))
+	}
+	return fmt.Errorf("stop services fail: %s", strings.Join(failures, "; "))
+}
+
+// stop runs the hook until the shutdown deadline, or for LateStopTimeout once the deadline passed
+func (m *Manager) stop(hook stopHook, deadline time.Time) error {
+	if late := time.Now().Add(minDuration(LateStopTimeout, m.timeout)); late.After(deadline) {
+		deadline = late
+	}
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- hook.stop(ctx)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return errors.New("timed out, abandoned")
+	}
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,58 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManager_StopsInReverseOrder(t *testing.T) {
+	m := New(time.Second)
+	var stopped []string
+	for _, name := range []string{"database", "dispatcher", "grpc server"} {
+		name := name
+		m.OnStop(name, func(ctx context.Context) error {
+			stopped = append(stopped, name)
+			return nil
+		})
+	}
+	failure := errors.New("serve failed")
+	m.Fail(failure)
+	assert.ErrorIs(t, m.Wait(), failure)
+	assert.Equal(t, []string{"grpc server", "dispatcher", "database"}, stopped)
+}
+
+func TestManager_Timeout(t *testing.T) {
+	m := New(50 * time.Millisecond)
+	m.OnStop("stuck", func(ctx context.Context) error {
+		time.Sleep(time.Second)
+		return nil
+	})
+	m.Fail(errors.New("serve failed"))
+	start := time.Now()
+	err := m.Wait()
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestManager_TimeoutRunsRemainingHooks(t *testing.T) {
+	m := New(50 * time.Millisecond)
+	closed := false
+	m.OnStop("database", func(ctx context.Context) error {
+		closed = true
+		return nil
+	})
+	m.OnStop("stuck", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	failure := errors.New("serve failed")
+	m.Fail(failure)
+	err := m.Wait()
+	assert.ErrorIs(t, err, failure)
+	assert.Contains(t, err.Error(), "stuck")
+	assert.True(t, closed, "the hooks after a timed out one must still run")
+}
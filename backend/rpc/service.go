@@ -1,33 +1,102 @@
 package rpc
 
 import (
+	"context"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/savour-labs/key-locker/backend/lifecycle"
 	"github.com/savour-labs/key-locker/config"
 	"github.com/savour-labs/key-locker/keydispatcher"
 	"github.com/savour-labs/key-locker/proto/keylocker"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
-	"net"
 )
 
-func StartService(conf *config.Config) {
+func StartService(conf *config.Config) error {
+	lc := lifecycle.New(time.Duration(conf.RpcServer.ShutdownTimeout) * time.Second)
 	dispatcher, err := keydispatcher.New(conf)
 	if err != nil {
 		log.Error("Setup dispatcher failed", "err", err)
-		panic(err)
+		return err
+	}
+	lc.OnStop("dispatcher", dispatcher.Close)
+
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(dispatcher.Interceptor),
+		grpc.StreamInterceptor(dispatcher.StreamInterceptor),
+	}
+	if conf.RpcServer.MaxMsgSize > 0 {
+		opts = append(opts,
+			grpc.MaxRecvMsgSize(conf.RpcServer.MaxMsgSize),
+			grpc.MaxSendMsgSize(conf.RpcServer.MaxMsgSize),
+		)
 	}
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(dispatcher.Interceptor))
-	defer grpcServer.GracefulStop()
+	grpcServer := grpc.NewServer(opts...)
 	keylocker.RegisterLeyLockerServiceServer(grpcServer, dispatcher)
 	listen, err := net.Listen("tcp", ":"+conf.RpcServer.Port)
 	if err != nil {
 		log.Error("net listen failed", "err", err)
-		panic(err)
+		dispatcher.Close(context.Background())
+		return err
 	}
 	reflection.Register(grpcServer)
+	lc.OnStop("grpc server", func(ctx context.Context) error {
+		return gracefulStop(ctx, grpcServer)
+	})
+	go reloadOnSignal(dispatcher)
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	schedulerDone := make(chan struct{})
+	go func() {
+		dispatcher.RunInheritance(schedulerCtx)
+		close(schedulerDone)
+	}()
+	lc.OnStop("inheritance scheduler", func(ctx context.Context) error {
+		stopScheduler()
+		select {
+		case <-schedulerDone:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	go func() {
+		if err := grpcServer.Serve(listen); err != nil {
+			log.Error("grpc server serve failed", "err", err)
+			lc.Fail(err)
+		}
+	}()
 	log.Info("savour dao start success", "port", conf.RpcServer.Port)
-	if err := grpcServer.Serve(listen); err != nil {
-		log.Error("grpc server serve failed", "err", err)
-		panic(err)
+	return lc.Wait()
+}
+
+// gracefulStop stops accepting rpcs and waits for the running ones, they are cancelled once ctx is done
+func gracefulStop(ctx context.Context, grpcServer *grpc.Server) error {
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		grpcServer.Stop()
+		return ctx.Err()
+	}
+}
+
+// reloadOnSignal reloads the config file and the changed adaptors on every SIGHUP
+func reloadOnSignal(dispatcher *keydispatcher.Dispatcher) {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	for range sighup {
+		if _, err := dispatcher.Reload(); err != nil {
+			log.Error("reload config failed, keep running with the old one", "err", err)
+		}
 	}
 }
@@ -5,7 +5,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/google/uuid"
 	"github.com/savour-labs/key-locker/blockchain"
 	"github.com/savour-labs/key-locker/blockchain/fallback"
 	"github.com/savour-labs/key-locker/config"
@@ -30,11 +35,57 @@ func NewChainAdaptor(conf *config.Config) (blockchain.KeyAdaptor, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &KeyAdaptor{
+	adaptor := &KeyAdaptor{
 		clients: client,
 		conf:    conf,
 		repo:    model.NewRepo(db.InitDB(conf.Database)),
-	}, nil
+	}
+	if err := adaptor.resumeJobs(); err != nil {
+		client.Close()
+		return nil, err
+	}
+	return adaptor, nil
+}
+
+// resumeJobs tracks again the transactions which were not final when the process stopped
+func (a *KeyAdaptor) resumeJobs() error {
+	jobs, err := a.repo.ListUnfinishedJobs(context.Background(), ChainName)
+	if err != nil {
+		return fmt.Errorf("repo.ListUnfinishedJobs fail, err: [%w]", err)
+	}
+	for _, job := range jobs {
+		a.clients.TrackTx(common.HexToHash(job.TxHash), job.CreatedAt, a.updateJob)
+	}
+	return nil
+}
+
+// trackJob persists the job of the sent transaction and follows it until it is final
+func (a *KeyAdaptor) trackJob(ctx context.Context, walletUuid string, txHash common.Hash) (*model.Job, error) {
+	job := &model.Job{
+		JobId:                 uuid.NewString(),
+		KeyUuid:               walletUuid,
+		Chain:                 ChainName,
+		TxHash:                txHash.Hex(),
+		Status:                model.JobStatusPending,
+		RequiredConfirmations: a.clients.Confirmations(),
+	}
+	if err := a.repo.CreateJob(ctx, job); err != nil {
+		return nil, fmt.Errorf("repo.CreateJob fail, txHash, %s, err: [%w]", txHash.Hex(), err)
+	}
+	a.clients.TrackTx(txHash, time.Now(), a.updateJob)
+	return job, nil
+}
+
+func (a *KeyAdaptor) updateJob(txHash common.Hash, status TxStatus) {
+	if err := a.repo.UpdateJobStatus(context.Background(), txHash.Hex(), status.Status, status.BlockNumber, status.Confirmations); err != nil {
+		log.Error("update job status fail", "txHash", txHash.Hex(), "status", status.Status, "err", err)
+	}
+}
+
+// Close waits for the transactions being tracked and releases the rpc and database connections
+func (a *KeyAdaptor) Close() error {
+	a.clients.Close()
+	return a.repo.Close()
 }
 
 func (a *KeyAdaptor) bytesCombine(pBytes ...[]byte) []byte {
@@ -55,36 +106,30 @@ func (a *KeyAdaptor) GetSupportChain(req *keylocker.SupportChainReq) (*keylocker
 }
 
 func (a *KeyAdaptor) GetSocialKey(ctx context.Context, req *keylocker.GetSocialKeyReq) (*keylocker.GetSocialKeyRep, error) {
-	uuidByte := []byte(req.WalletUuid)
-	var uuidByte32 [UuidSize]byte
-	copy(uuidByte32[:], uuidByte)
-
-	ret, err := a.clients.QuerySocialKey(uuidByte32)
+	rows, err := a.repo.SelectKeys(ctx, req.WalletUuid, ChainName, req.KeyId, req.Version)
+	if errors.Is(err, model.ErrKeyNotFound) {
+		return &keylocker.GetSocialKeyRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  err.Error(),
+		}, nil
+	}
 	if err != nil {
-		return nil, fmt.Errorf("GetSocialKey fail, req, %v, err: [%w]", req, err)
-	}
-	//// get rsa key from db
-	//sec, err := a.repo.GetByUID(ctx, req.WalletUuid)
-	//if err != nil {
-	//	return nil, fmt.Errorf("repo.GetByUID fail, req, %v, err: [%w]", req, err)
-	//}
-	//
-	//// Decrypt rsa key
-	//pri, err := crypto.DecryptByAes(sec.RsaPriv)
-	//if err != nil {
-	//	return nil, fmt.Errorf("crypto.DecryptByAes fail, req, %v, err: [%w]", req, err)
-	//}
-	// Decrypt the content
+		return nil, fmt.Errorf("repo.SelectKeys fail, req, %v, err: [%w]", req, err)
+	}
 	keyList := make([]*keylocker.SocialKey, 0)
-	for _, vkey := range ret {
-		//key, err := crypto.NewRsa(sec.RsaPub, string(pri)).Decrypt(v)
-		//if err != nil {
-		//	return nil, fmt.Errorf("RSA.Decrypt fail, req, %v, err: [%w]", req, err)
-		//}
-		keyList = append(keyList, &keylocker.SocialKey{
-			Id:  "",
-			Key: string(vkey),
-		})
+	for _, row := range rows {
+		ret, err := a.clients.QuerySocialKey(keySlot(row))
+		if err != nil {
+			return nil, fmt.Errorf("GetSocialKey fail, req, %v, err: [%w]", req, err)
+		}
+		for _, vkey := range ret {
+			keyList = append(keyList, &keylocker.SocialKey{
+				Id:      row.KeyId,
+				Key:     string(vkey),
+				Label:   row.Label,
+				Version: row.Version,
+			})
+		}
 	}
 
 	return &keylocker.GetSocialKeyRep{
@@ -125,6 +170,9 @@ func (a *KeyAdaptor) SetSocialKey(ctx context.Context, req *keylocker.SetSocialK
 		}).Error; e != nil {
 			return nil, fmt.Errorf("DB.Create fail, req, %v, err: [%w]", req, e)
 		}
+	} else if sec.RsaPriv == "" {
+		// the wallet was transferred to a public key, its owner holds the private key
+		pub = sec.RsaPub
 	} else {
 		priTmp, err := crypto.AesDecrypt([]byte(sec.RsaPriv), a.bytesCombine(dcrypted_pwd, dcrypted_scode))
 		if err != nil {
@@ -139,25 +187,139 @@ func (a *KeyAdaptor) SetSocialKey(ctx context.Context, req *keylocker.SetSocialK
 		return nil, fmt.Errorf("RSA.Encrypt fail, req, %v, err: [%w]", req, err)
 	}
 
-	uuidByte := []byte(req.WalletUuid)
-	var uuidByte32 [UuidSize]byte
-	copy(uuidByte32[:], uuidByte)
-
-	if err := a.clients.AppendSocialKey(uuidByte32, [][]byte{key}); err != nil {
-		return nil, err
-	}
-
-	// insert into db
-	if e := a.repo.DB.Create(&model.Key{
+	// reserve the version before sending, its slot on the contract depends on it. A send failing releases
+	// it here, a transaction reverting or dropped later releases it when its job is updated.
+	row := &model.Key{
 		KeySecret: req.Password,
 		KeyUuid:   req.WalletUuid,
-	}).Error; e != nil {
-		return nil, fmt.Errorf("DB.Create fail, req, %v, err: [%w]", req, e)
+		Chain:     ChainName,
+		Scheme:    crypto.RsaScheme,
+		KeyId:     req.KeyId,
+		Label:     req.Label,
+	}
+	if err := a.repo.CreateKeyVersion(ctx, row); errors.Is(err, model.ErrKeyNotFound) {
+		return &keylocker.SetSocialKeyRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("repo.CreateKeyVersion fail, req, %v, err: [%w]", req, err)
+	}
+	txHash, err := a.clients.AppendSocialKey(keySlot(row), [][]byte{key})
+	if err != nil {
+		if e := a.repo.DeleteKeys(ctx, []uint{row.ID}); e != nil {
+			log.Error("release key version fail", "keyId", row.KeyId, "version", row.Version, "err", e)
+		}
+		return nil, err
+	}
+	job, err := a.trackJob(ctx, req.WalletUuid, txHash)
+	if err != nil {
+		return nil, fmt.Errorf("trackJob fail, req, %v, err: [%w]", req, err)
+	}
+	if e := a.repo.UpdateKey(ctx, row.ID, map[string]interface{}{"tx_hash": job.TxHash}); e != nil {
+		return nil, fmt.Errorf("repo.UpdateKey fail, req, %v, err: [%w]", req, e)
 	}
 	return &keylocker.SetSocialKeyRep{
-		Code: keylocker.ReturnCode_SUCCESS,
-		Msg:  "set social key success",
-		Pub:  pub,
-		Priv: string(encryptPriv),
+		Code:    keylocker.ReturnCode_SUCCESS,
+		Msg:     "set social key success",
+		Pub:     pub,
+		Priv:    string(encryptPriv),
+		JobId:   job.JobId,
+		TxHash:  job.TxHash,
+		KeyId:   row.KeyId,
+		Version: row.Version,
 	}, nil
 }
+
+// DeleteSocialKey clears every slot of the wallet on the contract and soft deletes the keys,
+// the returned jobs follow the transactions
+func (a *KeyAdaptor) DeleteSocialKey(ctx context.Context, req *keylocker.DeleteSocialKeyReq) (*keylocker.DeleteSocialKeyRep, error) {
+	rows, err := a.repo.ListKeysByUID(ctx, req.WalletUuid, ChainName)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListKeysByUID fail, req, %v, err: [%w]", req, err)
+	}
+	jobs, err := a.clearSlots(ctx, req.WalletUuid, rows)
+	if err != nil {
+		return nil, fmt.Errorf("clearSlots fail, req, %v, err: [%w]", req, err)
+	}
+	if err := a.repo.DeleteKeysByUID(ctx, req.WalletUuid, ChainName); err != nil {
+		return nil, fmt.Errorf("repo.DeleteKeysByUID fail, req, %v, err: [%w]", req, err)
+	}
+	rep := &keylocker.DeleteSocialKeyRep{
+		Code: keylocker.ReturnCode_SUCCESS,
+		Msg:  "delete social key success",
+	}
+	for _, job := range jobs {
+		rep.JobIds = append(rep.JobIds, job.JobId)
+		rep.TxHashes = append(rep.TxHashes, job.TxHash)
+	}
+	return rep, nil
+}
+
+// PruneSocialKey clears the slots of the old versions of the key and soft deletes them
+func (a *KeyAdaptor) PruneSocialKey(ctx context.Context, req *keylocker.PruneSocialKeyReq) (*keylocker.PruneSocialKeyRep, error) {
+	versions, err := a.repo.ListKeyVersions(ctx, req.WalletUuid, ChainName, req.KeyId)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListKeyVersions fail, req, %v, err: [%w]", req, err)
+	}
+	if len(versions) == 0 {
+		return &keylocker.PruneSocialKeyRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  model.ErrKeyNotFound.Error(),
+		}, nil
+	}
+	pruned := model.PrunableVersions(versions, req.BeforeVersion)
+	jobs, err := a.clearSlots(ctx, req.WalletUuid, pruned)
+	if err != nil {
+		return nil, fmt.Errorf("clearSlots fail, req, %v, err: [%w]", req, err)
+	}
+	rep := &keylocker.PruneSocialKeyRep{
+		Code: keylocker.ReturnCode_SUCCESS,
+		Msg:  "prune social key success",
+	}
+	ids := make([]uint, 0, len(pruned))
+	for _, k := range pruned {
+		ids = append(ids, k.ID)
+		rep.PrunedVersions = append(rep.PrunedVersions, k.Version)
+	}
+	if err := a.repo.DeleteKeys(ctx, ids); err != nil {
+		return nil, fmt.Errorf("repo.DeleteKeys fail, req, %v, err: [%w]", req, err)
+	}
+	for _, job := range jobs {
+		rep.JobIds = append(rep.JobIds, job.JobId)
+		rep.TxHashes = append(rep.TxHashes, job.TxHash)
+	}
+	return rep, nil
+}
+
+// clearSlots sends a deleteSocialKey for every distinct slot of the keys
+func (a *KeyAdaptor) clearSlots(ctx context.Context, walletUuid string, keys []*model.Key) ([]*model.Job, error) {
+	var jobs []*model.Job
+	cleared := make(map[[UuidSize]byte]bool)
+	for _, k := range keys {
+		slot := keySlot(k)
+		if cleared[slot] {
+			continue
+		}
+		txHash, err := a.clients.DeleteSocialKey(slot)
+		if err != nil {
+			return nil, err
+		}
+		job, err := a.trackJob(ctx, walletUuid, txHash)
+		if err != nil {
+			return nil, err
+		}
+		cleared[slot] = true
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// keySlot is where the contract holds the version of the key, keys stored before versioning
+// carry the slot they were written to
+func keySlot(k *model.Key) [UuidSize]byte {
+	if k.Slot != "" {
+		return common.HexToHash(k.Slot)
+	}
+	return ethcrypto.Keccak256Hash([]byte(fmt.Sprintf("%s/%s/%d", k.KeyUuid, k.KeyId, k.Version)))
+}
@@ -3,6 +3,9 @@ package ethereum
 import (
 	"context"
 	"crypto/ecdsa"
+	"errors"
+
+	geth "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -12,18 +15,24 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/savour-labs/key-locker/blockchain/ethereum/bindings"
 	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/model"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 )
 
 const (
 	UuidSize     = 32
 	TaskInterval = 12
+	// DropGracePeriod is how long a sent transaction may go unmined before it counts as dropped once unknown to the node
+	DropGracePeriod = 5 * TaskInterval * time.Second
 )
 
 type KeyLockerClient struct {
 	context               context.Context
+	cancel                context.CancelFunc
+	receipts              sync.WaitGroup
 	walletAddress         common.Address
 	ethClient             *ethclient.Client
 	klContract            *bindings.KeyLocker
@@ -31,6 +40,8 @@ type KeyLockerClient struct {
 	PrivKey               *ecdsa.PrivateKey
 	confirmReceiptTimeout time.Duration
 	confirmations         int64
+	// nonceMu keeps a nonce from being given to two transactions between its query and the send
+	nonceMu sync.Mutex
 }
 
 func NewKeyLockerClient(conf *config.Config) (*KeyLockerClient, error) {
@@ -54,96 +65,189 @@ func NewKeyLockerClient(conf *config.Config) (*KeyLockerClient, error) {
 	if err != nil {
 		return nil, err
 	}
+	ctx, cancel := context.WithCancel(context.Background())
 	return &KeyLockerClient{
-		context:               context.Background(),
+		context:               ctx,
+		cancel:                cancel,
 		walletAddress:         common.HexToAddress(conf.Fullnode.Eth.WalletAddr),
 		ethClient:             client,
 		klContract:            klContract,
 		ChainID:               chainConfig.ChainID,
 		PrivKey:               priv,
-		confirmReceiptTimeout: time.Duration(conf.Fullnode.Eth.TimeOut),
+		confirmReceiptTimeout: time.Duration(conf.Fullnode.Eth.TimeOut) * time.Second,
 		confirmations:         conf.Fullnode.Eth.Confirmations,
 	}, nil
 }
 
-func (kl KeyLockerClient) AppendSocialKey(uuid [UuidSize]byte, keys [][]byte) error {
-	nonce64, err := kl.ethClient.NonceAt(
-		kl.context, kl.walletAddress, nil,
+// AppendSocialKey sends the transaction storing the keys of uuid and returns its hash
+func (kl *KeyLockerClient) AppendSocialKey(uuid [UuidSize]byte, keys [][]byte) (common.Hash, error) {
+	kl.nonceMu.Lock()
+	defer kl.nonceMu.Unlock()
+	opts, err := kl.transactOpts()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	tx, err := kl.klContract.SetSocialKey(opts, uuid, keys)
+	if err != nil {
+		log.Error("can not to set social key")
+		return common.Hash{}, err
+	}
+	return kl.sendTx(tx)
+}
+
+// DeleteSocialKey sends the transaction clearing the keys of uuid and returns its hash
+func (kl *KeyLockerClient) DeleteSocialKey(uuid [UuidSize]byte) (common.Hash, error) {
+	kl.nonceMu.Lock()
+	defer kl.nonceMu.Unlock()
+	opts, err := kl.transactOpts()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	tx, err := kl.klContract.DeleteSocialKey(opts, uuid)
+	if err != nil {
+		log.Error("can not to delete social key")
+		return common.Hash{}, err
+	}
+	return kl.sendTx(tx)
+}
+
+// transactOpts builds the options of the next transaction, the caller holds nonceMu until it is sent
+func (kl *KeyLockerClient) transactOpts() (*bind.TransactOpts, error) {
+	// the pending nonce counts the transactions sent but not mined yet
+	nonce64, err := kl.ethClient.PendingNonceAt(
+		kl.context, kl.walletAddress,
 	)
 	if err != nil {
 		log.Error("can not to get current nonce", "err", err)
-		return err
+		return nil, err
 	}
 	nonce := new(big.Int).SetUint64(nonce64)
 	gasPrice, err := kl.ethClient.SuggestGasPrice(context.Background())
 	if err != nil {
 		log.Error("cannot fetch gas price")
-		return err
+		return nil, err
 	}
 	opts, err := bind.NewKeyedTransactorWithChainID(
 		kl.PrivKey, kl.ChainID,
 	)
+	if err != nil {
+		return nil, err
+	}
 	opts.Context = kl.context
 	opts.Nonce = nonce
 	opts.NoSend = true
 	opts.GasPrice = gasPrice
-	tx, err := kl.klContract.SetSocialKey(opts, uuid, keys)
-	if err != nil {
-		log.Error("can not to set social key")
-		return err
-	}
+	return opts, nil
+}
+
+func (kl *KeyLockerClient) sendTx(tx *types.Transaction) (common.Hash, error) {
 	if err := kl.ethClient.SendTransaction(kl.context, tx); err != nil {
 		log.Error("can not to send transaction to l1 chain")
-		return err
-	}
-	confirmTxReceipt := func(txHash common.Hash) *types.Receipt {
-		ctx, cancel := context.WithTimeout(context.Background(), kl.confirmReceiptTimeout)
-		queryTicker := time.NewTicker(TaskInterval)
-		defer func() {
-			cancel()
-			queryTicker.Stop()
-		}()
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
+}
+
+// TxStatus is the state of a sent transaction, Status is one of the model.JobStatus values
+type TxStatus struct {
+	Status        string
+	BlockNumber   uint64
+	Confirmations uint64
+}
+
+// TrackTx follows the transaction in the background until it is confirmed, reverted or dropped,
+// every change of its status is passed to onUpdate. Tracking stops when the client is closed.
+func (kl *KeyLockerClient) TrackTx(txHash common.Hash, sentAt time.Time, onUpdate func(txHash common.Hash, status TxStatus)) {
+	kl.receipts.Add(1)
+	go func() {
+		defer kl.receipts.Done()
+		queryTicker := time.NewTicker(TaskInterval * time.Second)
+		defer queryTicker.Stop()
+		last := TxStatus{Status: model.JobStatusPending}
 		for {
-			receipt, err := kl.ethClient.TransactionReceipt(context.Background(), txHash)
-			switch {
-			case receipt != nil:
-				txHeight := receipt.BlockNumber.Uint64()
-				tipHeight, err := kl.ethClient.BlockNumber(context.Background())
-				if err != nil {
-					log.Error("can not to fetch block number", "err", err)
-					break
-				}
-				log.Info("Transaction mined, checking confirmations",
-					"txHash", txHash, "txHeight", txHeight,
-					"tipHeight", tipHeight,
+			status, err := kl.txStatus(txHash, sentAt)
+			if err != nil {
+				log.Error("failed to query transaction status", "txHash", txHash.String(), "err", err)
+			} else if status != last {
+				log.Info("Transaction status changed",
+					"txHash", txHash, "status", status.Status,
+					"blockNumber", status.BlockNumber,
+					"confirmations", status.Confirmations,
 					"numConfirmations", kl.confirmations)
-				if txHeight+uint64(kl.confirmations) < tipHeight {
-					reverted := receipt.Status == 0
-					log.Info("Transaction confirmed",
-						"txHash", txHash,
-						"reverted", reverted)
-					return receipt
-				}
-			case err != nil:
-				log.Error("failed to query receipt for transaction", "txHash", txHash.String())
-			default:
+				onUpdate(txHash, status)
+				last = status
+			}
+			if model.IsFinal(last.Status) {
+				return
 			}
 			select {
-			case <-ctx.Done():
-				return nil
+			case <-kl.context.Done():
+				log.Warn("client closed, stop tracking transaction", "txHash", txHash.String(), "status", last.Status)
+				return
 			case <-queryTicker.C:
 			}
 		}
+	}()
+}
+
+func (kl *KeyLockerClient) txStatus(txHash common.Hash, sentAt time.Time) (TxStatus, error) {
+	receipt, err := kl.ethClient.TransactionReceipt(kl.context, txHash)
+	if errors.Is(err, geth.NotFound) {
+		// a transaction the node still knows may be mined later, whatever its age
+		if time.Since(sentAt) > kl.dropAfter() {
+			_, _, err := kl.ethClient.TransactionByHash(kl.context, txHash)
+			if errors.Is(err, geth.NotFound) {
+				return TxStatus{Status: model.JobStatusDropped}, nil
+			}
+			if err != nil {
+				return TxStatus{}, err
+			}
+		}
+		return TxStatus{Status: model.JobStatusPending}, nil
+	}
+	if err != nil {
+		return TxStatus{}, err
 	}
-	go confirmTxReceipt(tx.Hash())
+	txHeight := receipt.BlockNumber.Uint64()
+	if receipt.Status == types.ReceiptStatusFailed {
+		return TxStatus{Status: model.JobStatusReverted, BlockNumber: txHeight}, nil
+	}
+	tipHeight, err := kl.ethClient.BlockNumber(kl.context)
 	if err != nil {
-		return err
+		return TxStatus{}, err
+	}
+	status := TxStatus{Status: model.JobStatusMined, BlockNumber: txHeight}
+	if tipHeight >= txHeight {
+		status.Confirmations = tipHeight - txHeight + 1
+	}
+	if status.Confirmations >= uint64(kl.confirmations) {
+		status.Status = model.JobStatusConfirmed
 	}
-	return nil
+	return status, nil
+}
+
+// dropAfter is how long a sent transaction stays pending before the node is asked whether it still knows it,
+// the time_out of the config when it is shorter than DropGracePeriod
+func (kl *KeyLockerClient) dropAfter() time.Duration {
+	if kl.confirmReceiptTimeout > 0 && kl.confirmReceiptTimeout < DropGracePeriod {
+		return kl.confirmReceiptTimeout
+	}
+	return DropGracePeriod
+}
+
+// Confirmations is the number of blocks a transaction needs to be confirmed
+func (kl *KeyLockerClient) Confirmations() uint64 {
+	return uint64(kl.confirmations)
+}
 
+// Close stops tracking the pending transactions and closes the rpc connection
+func (kl *KeyLockerClient) Close() {
+	kl.cancel()
+	kl.receipts.Wait()
+	kl.ethClient.Close()
 }
 
-func (kl KeyLockerClient) QuerySocialKey(uuid [UuidSize]byte) ([][]byte, error) {
+func (kl *KeyLockerClient) QuerySocialKey(uuid [UuidSize]byte) ([][]byte, error) {
 	keys, err := kl.klContract.GetSocialKey(&bind.CallOpts{
 		Pending: false,
 		Context: kl.context,
@@ -1,6 +1,9 @@
 package fallback
 
 import (
+	"context"
+
+	"github.com/savour-labs/key-locker/config"
 	"github.com/savour-labs/key-locker/proto/keylocker"
 )
 
@@ -17,3 +20,17 @@ func (w *KeyAdaptor) SetSocialKey(request *keylocker.SetSocialKeyReq) (*keylocke
 func (a *KeyAdaptor) GetGasPrice(req *keylocker.GetSocialKeyReq) (*keylocker.SetSocialKeyRep, error) {
 	panic("implement me")
 }
+
+func (a *KeyAdaptor) DeleteSocialKey(ctx context.Context, req *keylocker.DeleteSocialKeyReq) (*keylocker.DeleteSocialKeyRep, error) {
+	return &keylocker.DeleteSocialKeyRep{
+		Code: keylocker.ReturnCode_ERROR,
+		Msg:  config.UnsupportedOperation,
+	}, nil
+}
+
+func (a *KeyAdaptor) PruneSocialKey(ctx context.Context, req *keylocker.PruneSocialKeyReq) (*keylocker.PruneSocialKeyRep, error) {
+	return &keylocker.PruneSocialKeyRep{
+		Code: keylocker.ReturnCode_ERROR,
+		Msg:  config.UnsupportedOperation,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/savour-labs/key-locker/blockchain"
 	"github.com/savour-labs/key-locker/blockchain/fallback"
 	"github.com/savour-labs/key-locker/config"
@@ -37,6 +38,14 @@ func NewChainAdaptor(conf *config.Config) (blockchain.KeyAdaptor, error) {
 	}, nil
 }
 
+// Close shuts the ipfs node down and releases the database connections
+func (a *KeyAdaptor) Close() error {
+	if err := a.ipfsClient.Close(); err != nil {
+		return err
+	}
+	return a.repo.Close()
+}
+
 func (a *KeyAdaptor) bytesCombine(pBytes ...[]byte) []byte {
 	length := len(pBytes)
 	s := make([][]byte, length)
@@ -57,36 +66,63 @@ func (a *KeyAdaptor) GetSupportChain(req *keylocker.SupportChainReq) (*keylocker
 // GetSocialKey
 // 1. req.uuid 取到链上的存储 req.key 的文件，
 // 2. 解密返回就行
+// 没有传 req.file_cid 时按 req.key_id 和 req.version 选取，都没有时返回该 uuid 每个 key 的最新版本
 func (a *KeyAdaptor) GetSocialKey(ctx context.Context, req *keylocker.GetSocialKeyReq) (*keylocker.GetSocialKeyRep, error) {
+	if req.FileCid == "" {
+		return a.getSocialKeys(ctx, req)
+	}
+	// a cid is only served to the wallet it was stored for
+	k, err := a.repo.GetKeyByCID(ctx, req.WalletUuid, req.FileCid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &keylocker.GetSocialKeyRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  model.ErrKeyNotFound.Error(),
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetKeyByCID fail, req, %v, err: [%w]", req, err)
+	}
 	// get file from ipfs
 	ret, err := a.ipfsClient.GetFile(ctx, req.FileCid)
 	if err != nil {
 		return nil, fmt.Errorf("ipfsClient.GetFile fail, req, %v, err: [%w]", req, err)
 	}
-	//// get rsa key from db
-	//sec, err := a.repo.GetByUID(ctx, req.WalletUuid)
-	//if err != nil {
-	//	return nil, fmt.Errorf("repo.GetByUID fail, req, %v, err: [%w]", req, err)
-	//}
-	//
-	//// Decrypt rsa key
-	//pri, err := crypto.DecryptByAes(sec.RsaPriv)
-	//if err != nil {
-	//	return nil, fmt.Errorf("crypto.DecryptByAes fail, req, %v, err: [%w]", req, err)
-	//}
-	//
-	//// Decrypt the content from ipfs
-	//key, err := crypto.NewRsa(sec.RsaPub, string(pri)).Decrypt(ret)
-	//if err != nil {
-	//	return nil, fmt.Errorf("RSA.Decrypt fail, req, %v, err: [%w]", req, err)
-	//}
+	socialKey := &keylocker.SocialKey{Id: k.KeyId, Key: string(ret), Label: k.Label, Version: k.Version}
 	return &keylocker.GetSocialKeyRep{
-		Code: keylocker.ReturnCode_SUCCESS,
-		Msg:  "get ipfs social key success",
-		KeyList: []*keylocker.SocialKey{&keylocker.SocialKey{
-			Id:  "",
-			Key: string(ret),
-		}},
+		Code:    keylocker.ReturnCode_SUCCESS,
+		Msg:     "get ipfs social key success",
+		KeyList: []*keylocker.SocialKey{socialKey},
+	}, nil
+}
+
+func (a *KeyAdaptor) getSocialKeys(ctx context.Context, req *keylocker.GetSocialKeyReq) (*keylocker.GetSocialKeyRep, error) {
+	keys, err := a.repo.SelectKeys(ctx, req.WalletUuid, ChainName, req.KeyId, req.Version)
+	if errors.Is(err, model.ErrKeyNotFound) {
+		return &keylocker.GetSocialKeyRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.SelectKeys fail, req, %v, err: [%w]", req, err)
+	}
+	keyList := make([]*keylocker.SocialKey, 0, len(keys))
+	for _, k := range keys {
+		ret, err := a.ipfsClient.GetFile(ctx, k.KeyCID)
+		if err != nil {
+			return nil, fmt.Errorf("ipfsClient.GetFile fail, req, %v, cid, %s, err: [%w]", req, k.KeyCID, err)
+		}
+		keyList = append(keyList, &keylocker.SocialKey{
+			Id:      k.KeyId,
+			Key:     string(ret),
+			Label:   k.Label,
+			Version: k.Version,
+		})
+	}
+	return &keylocker.GetSocialKeyRep{
+		Code:    keylocker.ReturnCode_SUCCESS,
+		Msg:     "get ipfs social key success",
+		KeyList: keyList,
 	}, nil
 }
 
@@ -124,8 +160,11 @@ func (a *KeyAdaptor) SetSocialKey(ctx context.Context, req *keylocker.SetSocialK
 		}).Error; e != nil {
 			return nil, fmt.Errorf("DB.Create fail, req, %v, err: [%w]", req, e)
 		}
+	} else if sec.RsaPriv == "" {
+		// the wallet was transferred to a public key, its owner holds the private key
+		pub = sec.RsaPub
 	} else {
-		priTmp, err := crypto.DecryptByAes(sec.RsaPriv)
+		priTmp, err := crypto.AesDecrypt([]byte(sec.RsaPriv), a.bytesCombine(dcrypted_pwd, dcrypted_scode))
 		if err != nil {
 			return nil, fmt.Errorf("crypto.DecryptByAes fail, req, %v, pri, %s, err: [%w]", req, sec.RsaPriv, err)
 		}
@@ -139,18 +178,31 @@ func (a *KeyAdaptor) SetSocialKey(ctx context.Context, req *keylocker.SetSocialK
 	if err != nil {
 		return nil, fmt.Errorf("RSA.Encrypt fail, req, %v, err: [%w]", req, err)
 	}
+	// upload first, the row only becomes visible with its CID
 	cid, err := a.ipfsClient.AddFile(ctx, key)
 	if err != nil {
 		return nil, fmt.Errorf("ipfsClient.AddFile fail, req, %v, err: [%w]", req, err)
 	}
-
-	// insert into db
-	if e := a.repo.DB.Create(&model.Key{
+	row := &model.Key{
 		KeySecret: req.Password,
 		KeyCID:    cid,
 		KeyUuid:   req.WalletUuid,
-	}).Error; e != nil {
-		return nil, fmt.Errorf("DB.Create fail, req, %v, err: [%w]", req, e)
+		Chain:     ChainName,
+		Scheme:    crypto.RsaScheme,
+		KeyId:     req.KeyId,
+		Label:     req.Label,
+	}
+	if err := a.repo.CreateKeyVersion(ctx, row); err != nil {
+		if e := a.ipfsClient.Unpin(ctx, cid); e != nil {
+			log.Error("unpin unstored key fail", "cid", cid, "err", e)
+		}
+		if errors.Is(err, model.ErrKeyNotFound) {
+			return &keylocker.SetSocialKeyRep{
+				Code: keylocker.ReturnCode_NOT_FOUND,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return nil, fmt.Errorf("repo.CreateKeyVersion fail, req, %v, err: [%w]", req, err)
 	}
 
 	return &keylocker.SetSocialKeyRep{
@@ -159,5 +211,71 @@ func (a *KeyAdaptor) SetSocialKey(ctx context.Context, req *keylocker.SetSocialK
 		Pub:     pub,
 		Priv:    string(encryptPriv),
 		FileCid: cid,
+		KeyId:   row.KeyId,
+		Version: row.Version,
+	}, nil
+}
+
+// DeleteSocialKey
+// 1. 取消该 uuid 存储的全部文件的 pin，文件在下次 repo gc 时删除
+// 2. 软删除对应的 key 记录
+func (a *KeyAdaptor) DeleteSocialKey(ctx context.Context, req *keylocker.DeleteSocialKeyReq) (*keylocker.DeleteSocialKeyRep, error) {
+	keys, err := a.repo.ListKeysByUID(ctx, req.WalletUuid, ChainName)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListKeysByUID fail, req, %v, err: [%w]", req, err)
+	}
+	cids := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if k.KeyCID == "" {
+			// left by an upload that failed before the CID was stored
+			continue
+		}
+		if err := a.ipfsClient.Unpin(ctx, k.KeyCID); err != nil {
+			return nil, fmt.Errorf("ipfsClient.Unpin fail, req, %v, cid, %s, err: [%w]", req, k.KeyCID, err)
+		}
+		cids = append(cids, k.KeyCID)
+	}
+	if err := a.repo.DeleteKeysByUID(ctx, req.WalletUuid, ChainName); err != nil {
+		return nil, fmt.Errorf("repo.DeleteKeysByUID fail, req, %v, err: [%w]", req, err)
+	}
+	return &keylocker.DeleteSocialKeyRep{
+		Code:     keylocker.ReturnCode_SUCCESS,
+		Msg:      "delete ipfs social key success",
+		FileCids: cids,
 	}, nil
 }
+
+// PruneSocialKey
+// 取消旧版本文件的 pin 并软删除对应的 key 记录，最新版本始终保留
+func (a *KeyAdaptor) PruneSocialKey(ctx context.Context, req *keylocker.PruneSocialKeyReq) (*keylocker.PruneSocialKeyRep, error) {
+	versions, err := a.repo.ListKeyVersions(ctx, req.WalletUuid, ChainName, req.KeyId)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListKeyVersions fail, req, %v, err: [%w]", req, err)
+	}
+	if len(versions) == 0 {
+		return &keylocker.PruneSocialKeyRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  model.ErrKeyNotFound.Error(),
+		}, nil
+	}
+	rep := &keylocker.PruneSocialKeyRep{
+		Code: keylocker.ReturnCode_SUCCESS,
+		Msg:  "prune ipfs social key success",
+	}
+	pruned := model.PrunableVersions(versions, req.BeforeVersion)
+	ids := make([]uint, 0, len(pruned))
+	for _, k := range pruned {
+		if k.KeyCID != "" {
+			if err := a.ipfsClient.Unpin(ctx, k.KeyCID); err != nil {
+				return nil, fmt.Errorf("ipfsClient.Unpin fail, req, %v, cid, %s, err: [%w]", req, k.KeyCID, err)
+			}
+		}
+		ids = append(ids, k.ID)
+		rep.PrunedVersions = append(rep.PrunedVersions, k.Version)
+		rep.FileCids = append(rep.FileCids, k.KeyCID)
+	}
+	if err := a.repo.DeleteKeys(ctx, ids); err != nil {
+		return nil, fmt.Errorf("repo.DeleteKeys fail, req, %v, err: [%w]", req, err)
+	}
+	return rep, nil
+}
@@ -50,6 +50,11 @@ func New(ctx context.Context, networkNode []string, repoPath string) (*Client, e
 	}, nil
 }
 
+// Close stops the ipfs node and releases the repo lock
+func (c *Client) Close() error {
+	return c.node.Close()
+}
+
 // AddFile 添加文件，返回cid
 func (c *Client) AddFile(ctx context.Context, file []byte) (string, error) {
 	peerCidFile, err := c.ipfs.Unixfs().Add(ctx, files.NewBytesFile(file))
@@ -72,6 +77,19 @@ func (c *Client) GetFile(ctx context.Context, cidStr string) ([]byte, error) {
 	return io.ReadAll(files.ToFile(node))
 }
 
+// Unpin releases the file so the next repo gc removes it, a file which is not pinned is left as is
+func (c *Client) Unpin(ctx context.Context, cidStr string) error {
+	cid := icorepath.New(cidStr)
+	_, pinned, err := c.ipfs.Pin().IsPinned(ctx, cid)
+	if err != nil {
+		return err
+	}
+	if !pinned {
+		return nil
+	}
+	return c.ipfs.Pin().Rm(ctx, cid)
+}
+
 var loadPluginsOnce sync.Once
 
 // Spawns a node to be used just for this run (i.e. creates a tmp repo)
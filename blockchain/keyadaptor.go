@@ -10,4 +10,6 @@ type KeyAdaptor interface {
 	GetSupportChain(req *keylocker.SupportChainReq) (*keylocker.SupportChainRep, error)
 	SetSocialKey(ctx context.Context, req *keylocker.SetSocialKeyReq) (*keylocker.SetSocialKeyRep, error)
 	GetSocialKey(ctx context.Context, req *keylocker.GetSocialKeyReq) (*keylocker.GetSocialKeyRep, error)
+	DeleteSocialKey(ctx context.Context, req *keylocker.DeleteSocialKeyReq) (*keylocker.DeleteSocialKeyRep, error)
+	PruneSocialKey(ctx context.Context, req *keylocker.PruneSocialKeyReq) (*keylocker.PruneSocialKeyRep, error)
 }
@@ -0,0 +1,147 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/savour-labs/key-locker/blockchain"
+	"github.com/savour-labs/key-locker/blockchain/fallback"
+	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// SocketEnv is the unix socket the plugin process must serve KeyAdaptorPlugin on
+	SocketEnv = "KEY_LOCKER_PLUGIN_SOCKET"
+	// ConfigEnv is the config file the key locker was started with
+	ConfigEnv = "KEY_LOCKER_CONFIG"
+
+	StartTimeout = 10 * time.Second
+)
+
+// Client is a blockchain.KeyAdaptor backed by a plugin process
+type Client struct {
+	fallback.KeyAdaptor
+	chain  string
+	cmd    *exec.Cmd
+	conn   *grpc.ClientConn
+	client keylocker.KeyAdaptorPluginClient
+	sock   string
+}
+
+// Discover returns the plugin executables in dir by the chain they serve, which is the file name
+func Discover(dir string) (map[string]string, error) {
+	plugins := make(map[string]string)
+	if dir == "" {
+		return plugins, nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		if info.Mode()&0111 == 0 {
+			continue
+		}
+		plugins[entry.Name()] = filepath.Join(dir, entry.Name())
+	}
+	return plugins, nil
+}
+
+// NewFactory returns an adaptor factory which starts the plugin at path for chain
+func NewFactory(chain, path string) func(conf *config.Config) (blockchain.KeyAdaptor, error) {
+	return func(conf *config.Config) (blockchain.KeyAdaptor, error) {
+		return Start(chain, path, conf)
+	}
+}
+
+// Start launches the plugin process and connects to it
+func Start(chain, path string, conf *config.Config) (*Client, error) {
+	dir, err := os.MkdirTemp("", "key-locker-plugin")
+	if err != nil {
+		return nil, err
+	}
+	sock := filepath.Join(dir, "plugin.sock")
+
+	cmd := exec.Command(path)
+	cmd.Env = append(os.Environ(), SocketEnv+"="+sock, ConfigEnv+"="+conf.FilePath)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		os.RemoveAll(dir)
+		return nil, fmt.Errorf("start plugin fail, path, %s, err: [%w]", path, err)
+	}
+	c := &Client{chain: chain, cmd: cmd, sock: sock}
+
+	ctx, cancel := context.WithTimeout(context.Background(), StartTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, "unix://"+sock,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("connect plugin fail, path, %s, err: [%w]", path, err)
+	}
+	c.conn, c.client = conn, keylocker.NewKeyAdaptorPluginClient(conn)
+
+	desc, err := c.client.Describe(ctx, &keylocker.PluginDescribeReq{})
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("describe plugin fail, path, %s, err: [%w]", path, err)
+	}
+	if desc.Chain != chain {
+		c.Close()
+		return nil, fmt.Errorf("plugin %s serves chain %s, want %s", path, desc.Chain, chain)
+	}
+	log.Info("plugin started", "chain", chain, "path", path, "pid", cmd.Process.Pid)
+	return c, nil
+}
+
+func (c *Client) GetSupportChain(req *keylocker.SupportChainReq) (*keylocker.SupportChainRep, error) {
+	return c.client.GetSupportChain(context.Background(), req)
+}
+
+func (c *Client) SetSocialKey(ctx context.Context, req *keylocker.SetSocialKeyReq) (*keylocker.SetSocialKeyRep, error) {
+	return c.client.SetSocialKey(ctx, req)
+}
+
+func (c *Client) GetSocialKey(ctx context.Context, req *keylocker.GetSocialKeyReq) (*keylocker.GetSocialKeyRep, error) {
+	return c.client.GetSocialKey(ctx, req)
+}
+
+func (c *Client) DeleteSocialKey(ctx context.Context, req *keylocker.DeleteSocialKeyReq) (*keylocker.DeleteSocialKeyRep, error) {
+	return c.client.DeleteSocialKey(ctx, req)
+}
+
+func (c *Client) PruneSocialKey(ctx context.Context, req *keylocker.PruneSocialKeyReq) (*keylocker.PruneSocialKeyRep, error) {
+	return c.client.PruneSocialKey(ctx, req)
+}
+
+// Close disconnects from the plugin and stops its process
+func (c *Client) Close() error {
+	if c.conn != nil {
+		c.conn.Close()
+	}
+	defer os.RemoveAll(filepath.Dir(c.sock))
+	if err := c.cmd.Process.Kill(); err != nil {
+		return err
+	}
+	c.cmd.Wait()
+	log.Info("plugin stopped", "chain", c.chain)
+	return nil
+}
@@ -0,0 +1,71 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+
+	"github.com/savour-labs/key-locker/blockchain"
+	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"google.golang.org/grpc"
+)
+
+type server struct {
+	chain   string
+	adaptor blockchain.KeyAdaptor
+}
+
+// Serve is called from the main of a plugin binary, it serves the adaptor to the key locker
+// which started the process until the key locker stops it
+func Serve(chain string, adaptor blockchain.KeyAdaptor) error {
+	sock := os.Getenv(SocketEnv)
+	if sock == "" {
+		return errors.New(SocketEnv + " is not set, plugins must be started by key locker")
+	}
+	listen, err := net.Listen("unix", sock)
+	if err != nil {
+		return err
+	}
+	grpcServer := grpc.NewServer()
+	keylocker.RegisterKeyAdaptorPluginServer(grpcServer, &server{chain: chain, adaptor: adaptor})
+	return grpcServer.Serve(listen)
+}
+
+// LoadConfig loads the config file the key locker was started with
+func LoadConfig() (*config.Config, error) {
+	var conf config.Config
+	if err := config.LoadConfigFile(os.Getenv(ConfigEnv), &conf); err != nil {
+		return nil, err
+	}
+	return &conf, nil
+}
+
+func (s *server) Describe(ctx context.Context, req *keylocker.PluginDescribeReq) (*keylocker.PluginDescribeRep, error) {
+	return &keylocker.PluginDescribeRep{
+		Code:  keylocker.ReturnCode_SUCCESS,
+		Msg:   "describe plugin success",
+		Chain: s.chain,
+	}, nil
+}
+
+func (s *server) GetSupportChain(ctx context.Context, req *keylocker.SupportChainReq) (*keylocker.SupportChainRep, error) {
+	return s.adaptor.GetSupportChain(req)
+}
+
+func (s *server) SetSocialKey(ctx context.Context, req *keylocker.SetSocialKeyReq) (*keylocker.SetSocialKeyRep, error) {
+	return s.adaptor.SetSocialKey(ctx, req)
+}
+
+func (s *server) GetSocialKey(ctx context.Context, req *keylocker.GetSocialKeyReq) (*keylocker.GetSocialKeyRep, error) {
+	return s.adaptor.GetSocialKey(ctx, req)
+}
+
+func (s *server) DeleteSocialKey(ctx context.Context, req *keylocker.DeleteSocialKeyReq) (*keylocker.DeleteSocialKeyRep, error) {
+	return s.adaptor.DeleteSocialKey(ctx, req)
+}
+
+func (s *server) PruneSocialKey(ctx context.Context, req *keylocker.PruneSocialKeyReq) (*keylocker.PruneSocialKeyRep, error) {
+	return s.adaptor.PruneSocialKey(ctx, req)
+}
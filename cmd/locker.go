@@ -1,10 +1,16 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/savour-labs/key-locker/backend/api"
 	"github.com/savour-labs/key-locker/backend/rpc"
+	"github.com/savour-labs/key-locker/blockchain/ethereum"
+	"github.com/savour-labs/key-locker/blockchain/ipfs"
+	"github.com/savour-labs/key-locker/blockchain/moonbeam"
 
 	"github.com/savour-labs/key-locker/config"
 	"github.com/savour-labs/key-locker/db"
@@ -36,8 +42,7 @@ func Execute() {
 			Usage: "start api server",
 			Action: func(c *cli.Context) error {
 				server := api.NewServer(db.InitDB(cfg.Database), cfg.Server)
-				server.Run()
-				return nil
+				return server.Run()
 			},
 		},
 		{
@@ -45,10 +50,44 @@ func Execute() {
 			Usage: "migrate database",
 			Action: func(c *cli.Context) error {
 				dba := db.InitDB(cfg.Database)
-				if err := dba.AutoMigrate(&model.Key{}, &model.Secret{}); err != nil {
+				if err := dba.AutoMigrate(
+					&model.Key{},
+					&model.Secret{},
+					&model.ImportCheckpoint{},
+					&model.IdempotencyRecord{},
+					&model.Job{},
+					&model.AuditRecord{},
+					&model.RecoveryPolicy{},
+					&model.Guardian{},
+					&model.GuardianChange{},
+					&model.RecoveryCase{},
+					&model.RecoveryApproval{},
+					&model.RecoveryDelay{},
+					&model.InheritancePolicy{},
+					&model.InheritedKey{},
+					&model.ShareSet{},
+					&model.Share{},
+					&model.WalletFreeze{},
+					&model.LoginChallenge{},
+					&model.Session{},
+					&model.TotpSecret{},
+					&model.OneTimeCode{},
+					&model.IssuedSocialCode{},
+				); err != nil {
 					log.WithError(err).Fatal("Failed to migrate database")
 					return err
 				}
+				repo := model.NewRepo(dba)
+				chainOf, closeClients := legacyChainResolver(&cfg)
+				defer closeClients()
+				if err := repo.BackfillKeyVersions(context.Background(), ipfs.ChainName, chainOf); err != nil {
+					log.WithError(err).Fatal("Failed to backfill key versions")
+					return err
+				}
+				if err := repo.DropInheritanceEscrow(); err != nil {
+					log.WithError(err).Fatal("Failed to drop the inheritance escrow")
+					return err
+				}
 				return nil
 			},
 		},
@@ -56,12 +95,94 @@ func Execute() {
 			Name:  "start",
 			Usage: "start rpc server",
 			Action: func(c *cli.Context) error {
-				rpc.StartService(&cfg)
-				return nil
+				if err := cfg.Validate(); err != nil {
+					return err
+				}
+				return rpc.StartService(&cfg)
 			},
 		},
 	}
 	if err := app.Run(os.Args); err != nil {
-		log.Fatal("Failed to start application: %v", err)
+		log.Fatalf("Failed to start application: %v", err)
+	}
+}
+
+// legacySocialKeyClient queries the keys stored under a uuid in the key locker contract of an evm chain
+type legacySocialKeyClient interface {
+	QuerySocialKey(uuid [ethereum.UuidSize]byte) ([][]byte, error)
+	Close()
+}
+
+// legacyChainResolver returns the resolver of the evm chain whose contract holds the keys a wallet stored before
+// they had a chain, by querying the contract of each configured evm chain under the wallet uuid. A wallet found on
+// none of them, its keys deleted since, falls back to legacyEvmChain. The clients are dialed on the first query.
+func legacyChainResolver(conf *config.Config) (func(ctx context.Context, uid string) (string, error), func()) {
+	var names []string
+	var clients []legacySocialKeyClient
+	dial := func() error {
+		for _, c := range conf.Chains {
+			var client legacySocialKeyClient
+			var err error
+			switch c {
+			case ethereum.ChainName:
+				client, err = ethereum.NewKeyLockerClient(conf)
+			case moonbeam.ChainName:
+				client, err = moonbeam.NewKeyLockerClient(conf)
+			default:
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("new %s client fail, err: [%w]", c, err)
+			}
+			names = append(names, c)
+			clients = append(clients, client)
+		}
+		return nil
+	}
+	dialed := false
+	chainOf := func(ctx context.Context, uid string) (string, error) {
+		if !dialed {
+			dialed = true
+			if err := dial(); err != nil {
+				return "", err
+			}
+		}
+		var uuidSlot [ethereum.UuidSize]byte
+		copy(uuidSlot[:], uid)
+		var found []string
+		for i, client := range clients {
+			keys, err := client.QuerySocialKey(uuidSlot)
+			if err != nil {
+				return "", fmt.Errorf("query %s fail, err: [%w]", names[i], err)
+			}
+			if len(keys) > 0 {
+				found = append(found, names[i])
+			}
+		}
+		switch len(found) {
+		case 0:
+			return legacyEvmChain(conf.Chains), nil
+		case 1:
+			return found[0], nil
+		default:
+			return "", fmt.Errorf("keys of the wallet are stored on %s, the rows do not tell which chain each was written to", strings.Join(found, " and "))
+		}
+	}
+	closeClients := func() {
+		for _, c := range clients {
+			c.Close()
+		}
+	}
+	return chainOf, closeClients
+}
+
+// legacyEvmChain is the evm chain of the config the keys without a CID were stored on before they had a chain,
+// Ethereum when none is configured
+func legacyEvmChain(chains []string) string {
+	for _, c := range chains {
+		if c == ethereum.ChainName || c == moonbeam.ChainName {
+			return c
+		}
 	}
+	return ethereum.ChainName
 }
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"os"
 
 	"gopkg.in/yaml.v2"
@@ -19,7 +21,7 @@ type Eth struct {
 	KeyLockerAddr string `yaml:"key_locker_addr"`
 	WalletAddr    string `yaml:"wallet_addr"`
 	WalletPriv    string `yaml:"wallet_priv"`
-	TimeOut       int64  `yaml:"time_out"`
+	TimeOut       int64  `yaml:"time_out"` // seconds before an unmined transaction unknown to the node counts as dropped
 	Confirmations int64  `yaml:"confirmations"`
 }
 
@@ -37,6 +39,20 @@ type Config struct {
 	RpcServer *RpcServer `yaml:"rpcserver"`
 	Chains    []string   `yaml:"chains"`
 	AesKey    string     `yaml:"aes_key"`
+	PluginDir string     `yaml:"plugin_dir"`
+	Recovery  Recovery   `yaml:"recovery"`
+	Notify    Notify     `yaml:"notify"`
+	// Inheritance drives the inheritance policies of the wallets
+	Inheritance Inheritance `yaml:"inheritance"`
+	Sign        Sign        `yaml:"sign"`
+	Credentials Credentials `yaml:"credentials"`
+	Freeze      Freeze      `yaml:"freeze"`
+	Siwe        Siwe        `yaml:"siwe"`
+	Totp        Totp        `yaml:"totp"`
+	SocialCode  SocialCode  `yaml:"social_code"`
+
+	// FilePath is the file the config was loaded from, used when reloading.
+	FilePath string `yaml:"-"`
 }
 
 type Database struct {
@@ -48,12 +64,113 @@ type Database struct {
 }
 
 type Server struct {
-	Port  int  `yaml:"port"`
-	Debug bool `yaml:"debug"`
+	Port            int  `yaml:"port"`
+	Debug           bool `yaml:"debug"`
+	ShutdownTimeout int  `yaml:"shutdown_timeout"`
 }
 
 type RpcServer struct {
-	Port string `yaml:"port"`
+	Port       string `yaml:"port"`
+	AdminToken string `yaml:"admin_token"`
+	// MaxMsgSize raises the grpc message size limit in bytes, grpc defaults to 4MB
+	MaxMsgSize int `yaml:"max_msg_size"`
+	// ShutdownTimeout is the seconds given to in-flight rpcs and the backends to stop
+	ShutdownTimeout int `yaml:"shutdown_timeout"`
+	// IdempotencyWait is the seconds a duplicate request waits for the running one before it is rejected
+	IdempotencyWait int `yaml:"idempotency_wait"`
+	// IdempotencyExpiry is the seconds after which the key of a request which never finished is taken over
+	// by a retry, 600 when not set
+	IdempotencyExpiry int `yaml:"idempotency_expiry"`
+}
+
+type Recovery struct {
+	// ChainId is the chainId of the EIP-712 domain the guardians sign approvals in, 1 when not set
+	ChainId int64 `yaml:"chain_id"`
+	// CaseTTL is the seconds a recovery case can be approved and released, 7 days when not set
+	CaseTTL int `yaml:"case_ttl"`
+	// MaxOpenCases is the number of recovery cases a wallet can have open or pending at once, 3 when not set
+	MaxOpenCases int `yaml:"max_open_cases"`
+	// GuardianChangeDelay is the seconds a change of the guardians of a wallet waits, unless its recovery delay
+	// is longer, 3 days when not set
+	GuardianChangeDelay int `yaml:"guardian_change_delay"`
+}
+
+type Inheritance struct {
+	// Interval is the seconds between two checks of the inheritance deadlines, 60 when not set
+	Interval int `yaml:"interval"`
+	// WarnBefore is the seconds before the deadline the owner is warned when the policy does not set it
+	WarnBefore uint64 `yaml:"warn_before"`
+	// MinPeriod is the shortest inactivity period a policy can set, 30 days when not set
+	MinPeriod uint64 `yaml:"min_period"`
+}
+
+type Sign struct {
+	// AllowedTo restricts the recipients of the transactions and the verifying contracts of the typed data
+	// signed with the stored keys, any destination is allowed when it is empty
+	AllowedTo []string `yaml:"allowed_to"`
+}
+
+type Credentials struct {
+	// MaxFailures is the wrong passwords or social codes in a row which lock a wallet, 5 when not set
+	MaxFailures uint32 `yaml:"max_failures"`
+	// LockTime is the seconds a wallet stays locked, 900 when not set
+	LockTime int `yaml:"lock_time"`
+	// MinEntropy is the bits of guesses a new password is estimated at least, not checked when not set
+	MinEntropy float64 `yaml:"min_entropy"`
+	// BannedPasswords is a file of passwords refused whatever their entropy, one per line
+	BannedPasswords string `yaml:"banned_passwords"`
+	// MinSocialCodeLength is the characters of a new social code at least, not checked when not set
+	MinSocialCodeLength int `yaml:"min_social_code_length"`
+	// MaxAge is the seconds credentials store keys before they have to be changed, unlimited when not set
+	MaxAge int `yaml:"max_age"`
+}
+
+type Freeze struct {
+	// UnfreezeDelay is the seconds a wallet stays frozen after an unfreeze proven by a single factor, 86400 when not set
+	UnfreezeDelay int `yaml:"unfreeze_delay"`
+}
+
+// Siwe is the Sign-In with Ethereum (EIP-4361) login of the owner addresses
+type Siwe struct {
+	// Domain is the domain requesting the signature in the messages, the login is disabled when it is not set
+	Domain string `yaml:"domain"`
+	// Uri is the subject of the signature, https://<domain> when not set
+	Uri string `yaml:"uri"`
+	// ChainId is the chain the owner addresses are on, 1 when not set
+	ChainId int64 `yaml:"chain_id"`
+	// ChallengeTTL is the seconds a challenge can be signed, 300 when not set
+	ChallengeTTL int `yaml:"challenge_ttl"`
+	// SessionTTL is the seconds a session lasts, 900 when not set
+	SessionTTL int `yaml:"session_ttl"`
+}
+
+type Totp struct {
+	// Issuer names the locker in the authenticator apps, KeyLocker when not set
+	Issuer string `yaml:"issuer"`
+	// Skew is the 30 seconds time steps a code may be behind or ahead of the clock, 1 when not set
+	Skew int `yaml:"skew"`
+}
+
+// SocialCode drives the social codes issued by the locker
+type SocialCode struct {
+	// Required rejects the keys stored for a wallet without issued codes, otherwise only the wallets
+	// with issued codes are checked
+	Required bool `yaml:"required"`
+	// Length is the characters of an issued code, 8 when not set. The password and the social code
+	// together must be 16, 24 or 32 bytes long.
+	Length int `yaml:"length"`
+	// TTL is the seconds an issued code is valid, 86400 when not set
+	TTL int `yaml:"ttl"`
+	// MaxAttempts is the wrong codes given for a wallet which exhaust its codes, 5 when not set
+	MaxAttempts uint32 `yaml:"max_attempts"`
+}
+
+// Notify are the channels the owners of the wallets are notified through of the recovery events
+type Notify struct {
+	// Webhooks receive every event as a json POST
+	Webhooks []string `yaml:"webhooks"`
+	// Timeout is the seconds a webhook has to answer, 10 when not set
+	Timeout int `yaml:"timeout"`
 }
 
 type Ipfs struct {
@@ -75,8 +192,44 @@ func LoadConfigFile(filePath string, cfg *Config) error {
 		return err
 	}
 	defer file.Close()
-	return yaml.NewDecoder(file).Decode(cfg)
+	if err := yaml.NewDecoder(file).Decode(cfg); err != nil {
+		return err
+	}
+	cfg.FilePath = filePath
+	return nil
+}
+
+// Validate checks the fields the rpc server and the adaptors can not run without
+func (c *Config) Validate() error {
+	if c.RpcServer == nil || c.RpcServer.Port == "" {
+		return errors.New("rpcserver.port is required")
+	}
+	if c.Database == nil {
+		return errors.New("database is required")
+	}
+	if len(c.Chains) == 0 {
+		return errors.New("chains is empty")
+	}
+	switch len(c.AesKey) {
+	case 16, 24, 32:
+	default:
+		return fmt.Errorf("aes_key must be 16, 24 or 32 bytes, got %d", len(c.AesKey))
+	}
+	for _, chain := range c.Chains {
+		switch chain {
+		case "Ethereum", "Moonbeam":
+			if c.Fullnode.Eth == nil || c.Fullnode.Eth.RPCURL == "" {
+				return fmt.Errorf("fullnode.eth.rpc_url is required by chain %s", chain)
+			}
+		case "Ipfs":
+			if c.Fullnode.Ipfs == nil || c.Fullnode.Ipfs.RepoPath == "" {
+				return fmt.Errorf("fullnode.ipfs.repo_path is required by chain %s", chain)
+			}
+		}
+	}
+	return nil
 }
 
 const UnsupportedChain = "Unsupport chain"
 const UnsupportedOperation = UnsupportedChain
+const PermissionDenied = "Permission denied"
@@ -22,6 +22,9 @@ func pkcs7UnPadding(data []byte) ([]byte, error) {
 		return nil, errors.New("crpyto string error")
 	}
 	unPadding := int(data[length-1])
+	if unPadding == 0 || unPadding > length {
+		return nil, errors.New("crpyto string error")
+	}
 	return data[:(length - unPadding)], nil
 }
 
@@ -44,6 +47,9 @@ func AesDecrypt(data []byte, key []byte) ([]byte, error) {
 		return nil, err
 	}
 	blockSize := block.BlockSize()
+	if len(data)%blockSize != 0 {
+		return nil, errors.New("crpyto string error")
+	}
 	blockMode := cipher.NewCBCDecrypter(block, key[:blockSize])
 	crypted := make([]byte, len(data))
 	blockMode.CryptBlocks(crypted, data)
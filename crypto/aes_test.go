@@ -0,0 +1,18 @@
+package crypto
+
+import (
+	"testing"
+)
+
+func TestAesDecrypt_WrongKey(t *testing.T) {
+	data, err := AesEncrypt([]byte("social key"), []byte("1234567890abcdef"))
+	if err != nil {
+		t.Fatalf("encrypt fail: %v", err)
+	}
+	if plain, err := AesDecrypt(data, []byte("fedcba0987654321")); err == nil && string(plain) == "social key" {
+		t.Fatal("decrypt with a wrong key must not return the data")
+	}
+	if _, err := AesDecrypt(data[:len(data)-1], []byte("1234567890abcdef")); err == nil {
+		t.Fatal("decrypt of truncated data must fail")
+	}
+}
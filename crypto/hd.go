@@ -0,0 +1,225 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"math/big"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/mr-tron/base58"
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/crypto/ripemd160"
+)
+
+// HardenedOffset is added to the index of a hardened BIP32 child
+const HardenedOffset uint32 = 0x80000000
+
+// version bytes of the serialized extended public keys
+const (
+	XPubVersion uint32 = 0x0488b21e
+	TPubVersion uint32 = 0x043587cf
+)
+
+// version bytes of the bitcoin P2PKH addresses
+const (
+	BtcP2PKHVersion     byte = 0x00
+	BtcTestP2PKHVersion byte = 0x6f
+)
+
+var (
+	ErrInvalidMnemonic = errors.New("invalid bip39 mnemonic")
+	// ErrInvalidChild is returned for the rare indexes BIP32 skips, the next index has to be used
+	ErrInvalidChild = errors.New("invalid child key, use the next index")
+	ErrHardenedPub  = errors.New("hardened child of a public key")
+)
+
+// HDKey is a BIP32 extended key, private until it is neutered
+type HDKey struct {
+	priv      []byte
+	pub       []byte
+	chainCode []byte
+	depth     byte
+	parentFP  [4]byte
+	index     uint32
+}
+
+// NewHDKeyFromMnemonic returns the BIP32 master key of the seed of the BIP39 mnemonic and passphrase
+func NewHDKeyFromMnemonic(mnemonic, passphrase string) (*HDKey, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		return nil, ErrInvalidMnemonic
+	}
+	defer zero(seed)
+	return NewHDKey(seed)
+}
+
+// NewHDKey returns the BIP32 master key of the seed
+func NewHDKey(seed []byte) (*HDKey, error) {
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	defer zero(sum)
+	k := new(big.Int).SetBytes(sum[:32])
+	if k.Sign() == 0 || k.Cmp(ethcrypto.S256().Params().N) >= 0 {
+		return nil, errors.New("invalid master key, use another seed")
+	}
+	priv := append([]byte(nil), sum[:32]...)
+	return &HDKey{
+		priv:      priv,
+		pub:       pubOf(priv),
+		chainCode: append([]byte(nil), sum[32:]...),
+	}, nil
+}
+
+// IsPrivate reports whether the key can derive hardened children
+func (k *HDKey) IsPrivate() bool {
+	return k.priv != nil
+}
+
+// PublicKey returns the compressed public key
+func (k *HDKey) PublicKey() []byte {
+	return append([]byte(nil), k.pub...)
+}
+
+// ECDSAPublicKey returns the public key as an ecdsa key
+func (k *HDKey) ECDSAPublicKey() (*ecdsa.PublicKey, error) {
+	return ethcrypto.DecompressPubkey(k.pub)
+}
+
+// Neuter returns the public extended key of k
+func (k *HDKey) Neuter() *HDKey {
+	return &HDKey{
+		pub:       k.pub,
+		chainCode: k.chainCode,
+		depth:     k.depth,
+		parentFP:  k.parentFP,
+		index:     k.index,
+	}
+}
+
+// Child derives the child at index, index at or above HardenedOffset is hardened
+func (k *HDKey) Child(index uint32) (*HDKey, error) {
+	if index >= HardenedOffset && !k.IsPrivate() {
+		return nil, ErrHardenedPub
+	}
+	data := make([]byte, 0, 37)
+	if index >= HardenedOffset {
+		data = append(append(data, 0), k.priv...)
+	} else {
+		data = append(data, k.pub...)
+	}
+	data = appendUint32(data, index)
+	defer zero(data)
+	mac := hmac.New(sha512.New, k.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	defer zero(sum)
+
+	curve := ethcrypto.S256()
+	il := new(big.Int).SetBytes(sum[:32])
+	if il.Cmp(curve.Params().N) >= 0 {
+		return nil, ErrInvalidChild
+	}
+	child := &HDKey{
+		chainCode: append([]byte(nil), sum[32:]...),
+		depth:     k.depth + 1,
+		index:     index,
+	}
+	copy(child.parentFP[:], Hash160(k.pub)[:4])
+	if k.IsPrivate() {
+		il.Add(il, new(big.Int).SetBytes(k.priv))
+		il.Mod(il, curve.Params().N)
+		if il.Sign() == 0 {
+			return nil, ErrInvalidChild
+		}
+		child.priv = il.FillBytes(make([]byte, 32))
+		child.pub = pubOf(child.priv)
+		return child, nil
+	}
+	parent, err := ethcrypto.DecompressPubkey(k.pub)
+	if err != nil {
+		return nil, err
+	}
+	x, y := curve.ScalarBaseMult(sum[:32])
+	x, y = curve.Add(x, y, parent.X, parent.Y)
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return nil, ErrInvalidChild
+	}
+	child.pub = ethcrypto.CompressPubkey(&ecdsa.PublicKey{Curve: curve, X: x, Y: y})
+	return child, nil
+}
+
+// Derive derives the descendant of k along path
+func (k *HDKey) Derive(path []uint32) (*HDKey, error) {
+	res := k
+	for _, index := range path {
+		child, err := res.Child(index)
+		if err != nil {
+			return nil, err
+		}
+		if res != k {
+			res.Wipe()
+		}
+		res = child
+	}
+	return res, nil
+}
+
+// PublicString serializes the public extended key with the version bytes, as xpub or tpub
+func (k *HDKey) PublicString(version uint32) string {
+	data := make([]byte, 0, 82)
+	data = appendUint32(data, version)
+	data = append(data, k.depth)
+	data = append(data, k.parentFP[:]...)
+	data = appendUint32(data, k.index)
+	data = append(data, k.chainCode...)
+	data = append(data, k.pub...)
+	return base58Check(data)
+}
+
+// Wipe zeroes the private key, k can not be used afterwards
+func (k *HDKey) Wipe() {
+	zero(k.priv)
+	k.priv = nil
+}
+
+// Hash160 is ripemd160(sha256(data)), the hash bitcoin addresses commit to
+func Hash160(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	h := ripemd160.New()
+	h.Write(sum[:])
+	return h.Sum(nil)
+}
+
+// P2PKHAddress returns the bitcoin pay-to-pubkey-hash address of the compressed public key
+func P2PKHAddress(pub []byte, version byte) string {
+	return base58Check(append([]byte{version}, Hash160(pub)...))
+}
+
+func base58Check(data []byte) string {
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	return base58.Encode(append(data, second[:4]...))
+}
+
+func pubOf(priv []byte) []byte {
+	curve := ethcrypto.S256()
+	x, y := curve.ScalarBaseMult(priv)
+	return ethcrypto.CompressPubkey(&ecdsa.PublicKey{Curve: curve, X: x, Y: y})
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -0,0 +1,74 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// BIP32 test vector 1
+func TestHDKeyDerive(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	master, err := NewHDKey(seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		path []uint32
+		xpub string
+	}{
+		{nil, "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"},
+		{[]uint32{HardenedOffset}, "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw"},
+		{[]uint32{HardenedOffset, 1, HardenedOffset + 2, 2}, "xpub6FHa3pjLCk84BayeJxFW2SP4XRrFd1JYnxeLeU8EqN3vDfZmbqBqaGJAyiLjTAwm6ZLRQUMv1ZACTj37sR62cfN7fe5JnJ7dh8zL4fiyLHV"},
+	}
+	for _, c := range cases {
+		key, err := master.Derive(c.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := key.PublicString(XPubVersion); got != c.xpub {
+			t.Errorf("path %v: got %s, want %s", c.path, got, c.xpub)
+		}
+	}
+
+	// public derivation of the non hardened children matches the private one
+	account, _ := master.Derive([]uint32{HardenedOffset, 1, HardenedOffset + 2})
+	priv, _ := account.Derive([]uint32{2})
+	pub, err := account.Neuter().Derive([]uint32{2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(pub.PublicKey()) != hex.EncodeToString(priv.PublicKey()) {
+		t.Error("public derivation differs from the private one")
+	}
+	if _, err := account.Neuter().Child(HardenedOffset); err != ErrHardenedPub {
+		t.Errorf("hardened child of a public key: got %v", err)
+	}
+}
+
+func TestHDKeyFromMnemonic(t *testing.T) {
+	mnemonic := strings.Repeat("abandon ", 11) + "about"
+	master, err := NewHDKeyFromMnemonic(mnemonic, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	eth, _ := master.Derive([]uint32{HardenedOffset + 44, HardenedOffset + 60, HardenedOffset, 0, 0})
+	pub, _ := eth.ECDSAPublicKey()
+	if got := ethcrypto.PubkeyToAddress(*pub).Hex(); got != "0x9858EfFD232B4033E47d90003D41EC34EcaEda94" {
+		t.Errorf("ethereum address: got %s", got)
+	}
+	account, _ := master.Derive([]uint32{HardenedOffset + 44, HardenedOffset, HardenedOffset})
+	if got := account.PublicString(XPubVersion); got != "xpub6BosfCnifzxcFwrSzQiqu2DBVTshkCXacvNsWGYJVVhhawA7d4R5WSWGFNbi8Aw6ZRc1brxMyWMzG3DSSSSoekkudhUd9yLb6qx39T9nMdj" {
+		t.Errorf("bitcoin account xpub: got %s", got)
+	}
+	btc, _ := account.Derive([]uint32{0, 0})
+	if got := P2PKHAddress(btc.PublicKey(), BtcP2PKHVersion); got != "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA" {
+		t.Errorf("bitcoin address: got %s", got)
+	}
+
+	if _, err := NewHDKeyFromMnemonic(strings.Repeat("abandon ", 12), ""); err != ErrInvalidMnemonic {
+		t.Errorf("bad checksum: got %v", err)
+	}
+}
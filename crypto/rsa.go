@@ -8,9 +8,13 @@ import (
 	"crypto/x509"
 	"encoding/asn1"
 	"encoding/pem"
+	"errors"
 	"strings"
 )
 
+// RsaScheme names how the adaptors encrypt the stored keys with the rsa key pair of the wallet
+const RsaScheme = "rsa2048-pkcs1v15"
+
 type Rsa struct {
 	privateKey    string
 	publicKey     string
@@ -27,22 +31,68 @@ func NewRsa(publicKey, privateKey string) *Rsa {
 	return rsaObj
 }
 
+// LoadRsa is NewRsa returning an error when a key does not parse
+func LoadRsa(publicKey, privateKey string) (*Rsa, error) {
+	rsaObj := &Rsa{
+		privateKey: privateKey,
+		publicKey:  publicKey,
+	}
+	if err := rsaObj.load(); err != nil {
+		return nil, err
+	}
+	return rsaObj, nil
+}
+
+// PrivateKey returns the pem encoded private key the Rsa was loaded from
+func (r *Rsa) PrivateKey() string {
+	return r.privateKey
+}
+
 func (r *Rsa) init() {
+	_ = r.load()
+}
+
+func (r *Rsa) load() error {
 	if r.privateKey != "" {
 		block, _ := pem.Decode([]byte(r.privateKey))
+		if block == nil {
+			return errors.New("private key is not pem encoded")
+		}
 		if strings.Index(r.privateKey, "BEGIN RSA") > 0 {
-			r.rsaPrivateKey, _ = x509.ParsePKCS1PrivateKey(block.Bytes)
+			privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+			if err != nil {
+				return err
+			}
+			r.rsaPrivateKey = privateKey
 		} else { //pkcs8
-			privateKey, _ := x509.ParsePKCS8PrivateKey(block.Bytes)
-			r.rsaPrivateKey = privateKey.(*rsa.PrivateKey)
+			privateKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err != nil {
+				return err
+			}
+			rsaKey, ok := privateKey.(*rsa.PrivateKey)
+			if !ok {
+				return errors.New("private key is not a rsa key")
+			}
+			r.rsaPrivateKey = rsaKey
 		}
 	}
 
 	if r.publicKey != "" {
 		block, _ := pem.Decode([]byte(r.publicKey))
-		publicKey, _ := x509.ParsePKIXPublicKey(block.Bytes)
-		r.rsaPublicKey = publicKey.(*rsa.PublicKey)
+		if block == nil {
+			return errors.New("public key is not pem encoded")
+		}
+		publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return err
+		}
+		rsaKey, ok := publicKey.(*rsa.PublicKey)
+		if !ok {
+			return errors.New("public key is not a rsa key")
+		}
+		r.rsaPublicKey = rsaKey
 	}
+	return nil
 }
 
 func (r *Rsa) Encrypt(data []byte) ([]byte, error) {
@@ -30,3 +30,13 @@ func TestNewRsa(t *testing.T) {
 		verify,
 	)
 }
+
+func TestLoadRsa(t *testing.T) {
+	privateKey, publicKey := NewRsa("", "").CreatePkcs8Keys(2048)
+	if _, err := LoadRsa(publicKey, privateKey); err != nil {
+		t.Fatalf("load rsa fail: %v", err)
+	}
+	if _, err := LoadRsa(publicKey, "not a pem key"); err == nil {
+		t.Fatal("load rsa with a garbage private key must fail")
+	}
+}
@@ -0,0 +1,33 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// TotpPeriod is the RFC 6238 time step the authenticator apps use
+const TotpPeriod = 30 * time.Second
+
+// HOTP is the RFC 4226 code of the counter, HMAC-SHA1 truncated to digits decimal digits
+func HOTP(secret []byte, counter uint64, digits int) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, code%mod)
+}
+
+// TotpCounter is the RFC 6238 time step of t
+func TotpCounter(t time.Time) uint64 {
+	return uint64(t.Unix()) / uint64(TotpPeriod/time.Second)
+}
@@ -0,0 +1,31 @@
+package crypto
+
+import (
+	"testing"
+	"time"
+)
+
+// RFC 6238 appendix B, SHA1
+func TestTOTP(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+	for _, c := range cases {
+		if got := HOTP(secret, TotpCounter(time.Unix(c.unix, 0)), 8); got != c.code {
+			t.Errorf("time %d: got %s, want %s", c.unix, got, c.code)
+		}
+	}
+	// RFC 4226 appendix D
+	if got := HOTP(secret, 1, 6); got != "287082" {
+		t.Errorf("hotp counter 1: got %s", got)
+	}
+}
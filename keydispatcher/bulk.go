@@ -0,0 +1,196 @@
+package keydispatcher
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+const (
+	exportPageSize = 100
+	// maxImportResults bounds the failed items an import reports, the stream itself is unbounded
+	maxImportResults = 100
+)
+
+// ImportSocialKeys stores a stream of keys one at a time. The next item is only received once the
+// previous one is stored, so grpc flow control holds back a client sending faster than the backends
+// store. Items up to the checkpoint of the import were stored by an earlier attempt and are skipped,
+// which lets an interrupted import be resumed by sending the same items again. The checkpoint only moves
+// over items stored without a failure before them, so a resume retries the failed items and stores the
+// items after them again, an idempotency_key on the items keeps those from being stored twice.
+// It is restricted to the admin token, and reports the counts of the items with the first failed ones.
+func (d *Dispatcher) ImportSocialKeys(stream keylocker.LeyLockerService_ImportSocialKeysServer) error {
+	ctx := stream.Context()
+	res := &keylocker.ImportSocialKeysRep{}
+	var importId string
+	for {
+		item, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if !d.isAdmin(item.ConsumerToken) {
+			return d.closeImport(stream, res, keylocker.ReturnCode_ERROR, config.PermissionDenied)
+		}
+		if importId == "" {
+			if item.ImportId == "" {
+				return d.closeImport(stream, res, keylocker.ReturnCode_ERROR, "import_id is required")
+			}
+			importId = item.ImportId
+			if res.Checkpoint, err = d.repo().GetImportCheckpoint(ctx, importId); err != nil {
+				return d.closeImport(stream, res, keylocker.ReturnCode_ERROR, "get import checkpoint fail: "+err.Error())
+			}
+		} else if item.ImportId != importId {
+			return d.closeImport(stream, res, keylocker.ReturnCode_ERROR, "import_id changed within the import")
+		}
+		if item.Seq == 0 {
+			return d.closeImport(stream, res, keylocker.ReturnCode_ERROR, "seq must start at 1")
+		}
+		if item.Seq <= res.Checkpoint {
+			res.Imported++
+			continue
+		}
+		result := d.importSocialKey(ctx, item)
+		if result.Code != keylocker.ReturnCode_SUCCESS {
+			if res.Failed++; len(res.Results) < maxImportResults {
+				res.Results = append(res.Results, result)
+			}
+			continue
+		}
+		res.Imported++
+		if res.Failed > 0 {
+			continue
+		}
+		res.Checkpoint = item.Seq
+		if err := d.repo().SaveImportCheckpoint(ctx, importId, res.Checkpoint); err != nil {
+			return d.closeImport(stream, res, keylocker.ReturnCode_ERROR, "save import checkpoint fail: "+err.Error())
+		}
+	}
+	return d.closeImport(stream, res, keylocker.ReturnCode_SUCCESS, "import social keys success")
+}
+
+func (d *Dispatcher) importSocialKey(ctx context.Context, item *keylocker.ImportSocialKeysReq) *keylocker.ImportSocialKeyResult {
+	if item.Item == nil {
+		return &keylocker.ImportSocialKeyResult{
+			Seq:  item.Seq,
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "item is empty",
+		}
+	}
+	rep, err := d.SetSocialKey(ctx, item.Item)
+	if err != nil {
+		return &keylocker.ImportSocialKeyResult{
+			Seq:  item.Seq,
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}
+	}
+	return &keylocker.ImportSocialKeyResult{
+		Seq:     item.Seq,
+		Code:    rep.Code,
+		Msg:     rep.Msg,
+		FileCid: rep.FileCid,
+	}
+}
+
+func (d *Dispatcher) closeImport(stream keylocker.LeyLockerService_ImportSocialKeysServer, res *keylocker.ImportSocialKeysRep, code keylocker.ReturnCode, msg string) error {
+	res.Code, res.Msg = code, msg
+	return stream.SendAndClose(res)
+}
+
+// GetImportCheckpoint returns the last item stored by the import, it is restricted to the admin token
+func (d *Dispatcher) GetImportCheckpoint(ctx context.Context, req *keylocker.GetImportCheckpointReq) (*keylocker.GetImportCheckpointRep, error) {
+	if !d.isAdmin(req.ConsumerToken) {
+		return &keylocker.GetImportCheckpointRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  config.PermissionDenied,
+		}, nil
+	}
+	checkpoint, err := d.repo().GetImportCheckpoint(ctx, req.ImportId)
+	if err != nil {
+		return &keylocker.GetImportCheckpointRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, nil
+	}
+	return &keylocker.GetImportCheckpointRep{
+		Code:       keylocker.ReturnCode_SUCCESS,
+		Msg:        "get import checkpoint success",
+		Checkpoint: checkpoint,
+	}, nil
+}
+
+// ExportSocialKeys streams the wallets holding keys on the chain, one wallet per message, ordered by
+// cursor. It is restricted to the admin token. Send blocks while the client is not reading, and an
+// export is resumed by passing the cursor of the last received message as after_cursor.
+func (d *Dispatcher) ExportSocialKeys(req *keylocker.ExportSocialKeysReq, stream keylocker.LeyLockerService_ExportSocialKeysServer) error {
+	ctx := stream.Context()
+	if !d.isAdmin(req.ConsumerToken) {
+		return stream.Send(&keylocker.ExportSocialKeysRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  config.PermissionDenied,
+		})
+	}
+	cursor, sent := uint(req.AfterCursor), uint32(0)
+	for {
+		secrets, err := d.repo().ListSecrets(ctx, cursor, exportPageSize)
+		if err != nil {
+			return stream.Send(&keylocker.ExportSocialKeysRep{
+				Code:   keylocker.ReturnCode_ERROR,
+				Msg:    "list wallets fail: " + err.Error(),
+				Cursor: uint64(cursor),
+			})
+		}
+		if len(secrets) == 0 {
+			return nil
+		}
+		for _, sec := range secrets {
+			cursor = sec.ID
+			item := d.exportWallet(ctx, req.Chain, sec)
+			if item == nil {
+				continue
+			}
+			if err := stream.Send(item); err != nil {
+				return err
+			}
+			if sent++; req.Limit > 0 && sent >= req.Limit {
+				return nil
+			}
+		}
+	}
+}
+
+// exportWallet reads the keys of the wallet from the chain, it returns nil when the wallet has none
+func (d *Dispatcher) exportWallet(ctx context.Context, chain string, sec *model.Secret) *keylocker.ExportSocialKeysRep {
+	item := &keylocker.ExportSocialKeysRep{
+		Cursor:     uint64(sec.ID),
+		WalletUuid: sec.KeyUuid,
+		Pub:        sec.RsaPub,
+		Priv:       sec.RsaPriv,
+	}
+	adaptor, ok := d.acquire(chain)
+	if !ok {
+		item.Code, item.Msg = keylocker.ReturnCode_ERROR, config.UnsupportedOperation
+		return item
+	}
+	defer adaptor.release()
+	rep, err := adaptor.GetSocialKey(ctx, &keylocker.GetSocialKeyReq{
+		Chain:      chain,
+		WalletUuid: sec.KeyUuid,
+	})
+	if err != nil {
+		item.Code, item.Msg = keylocker.ReturnCode_ERROR, err.Error()
+		return item
+	}
+	if rep.Code == keylocker.ReturnCode_SUCCESS && len(rep.KeyList) == 0 {
+		return nil
+	}
+	item.Code, item.Msg, item.KeyList = rep.Code, rep.Msg, rep.KeyList
+	return item
+}
@@ -0,0 +1,117 @@
+package keydispatcher
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"google.golang.org/grpc"
+)
+
+// importStream feeds the items to ImportSocialKeys and keeps the rep it closes with
+type importStream struct {
+	grpc.ServerStream
+	items []*keylocker.ImportSocialKeysReq
+	rep   *keylocker.ImportSocialKeysRep
+}
+
+func (s *importStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *importStream) Recv() (*keylocker.ImportSocialKeysReq, error) {
+	if len(s.items) == 0 {
+		return nil, io.EOF
+	}
+	item := s.items[0]
+	s.items = s.items[1:]
+	return item, nil
+}
+
+func (s *importStream) SendAndClose(rep *keylocker.ImportSocialKeysRep) error {
+	s.rep = rep
+	return nil
+}
+
+func TestImportSocialKeysResumes(t *testing.T) {
+	d, adaptor := newTestDispatcher(t)
+	items := func(password string) []*keylocker.ImportSocialKeysReq {
+		var res []*keylocker.ImportSocialKeysReq
+		for i, key := range []string{"key one", "key two", "key three"} {
+			item := &keylocker.SetSocialKeyReq{
+				Chain:          testChain,
+				WalletUuid:     "wallet",
+				Key:            key,
+				Password:       sealed(t, testPassword),
+				SocialCode:     sealed(t, testSocialCode),
+				IdempotencyKey: key,
+			}
+			if i == 1 {
+				item.Password = sealed(t, password)
+			}
+			res = append(res, &keylocker.ImportSocialKeysReq{ConsumerToken: testAdminToken, ImportId: "import", Seq: uint64(i + 1), Item: item})
+		}
+		return res
+	}
+
+	// an import without the admin token stores nothing
+	denied := items(testPassword)
+	for _, item := range denied {
+		item.ConsumerToken = ""
+	}
+	stream := &importStream{items: denied}
+	if err := d.ImportSocialKeys(stream); err != nil {
+		t.Fatal(err)
+	}
+	if stream.rep.Code != keylocker.ReturnCode_ERROR || adaptor.setCalls() != 0 {
+		t.Fatalf("import without the admin token got %v with %d keys stored, want ERROR", stream.rep, adaptor.setCalls())
+	}
+
+	// the second item is sealed with a wrong password, the checkpoint stops before it
+	stream = &importStream{items: items("Wrong#pass1")}
+	if err := d.ImportSocialKeys(stream); err != nil {
+		t.Fatal(err)
+	}
+	if stream.rep.Checkpoint != 1 {
+		t.Fatalf("checkpoint is %d, want 1", stream.rep.Checkpoint)
+	}
+	if len(stream.rep.Results) != 1 || stream.rep.Results[0].Seq != 2 {
+		t.Fatalf("failed items are %v, want only the second one", stream.rep.Results)
+	}
+	if stream.rep.Imported != 2 || stream.rep.Failed != 1 {
+		t.Fatalf("%d items imported and %d failed, want 2 and 1", stream.rep.Imported, stream.rep.Failed)
+	}
+	if n := adaptor.setCalls(); n != 2 {
+		t.Fatalf("%d keys stored, want 2", n)
+	}
+
+	stream = &importStream{items: items(testPassword)}
+	if err := d.ImportSocialKeys(stream); err != nil {
+		t.Fatal(err)
+	}
+	if stream.rep.Checkpoint != 3 {
+		t.Fatalf("checkpoint is %d after the resume, want 3", stream.rep.Checkpoint)
+	}
+	for _, r := range stream.rep.Results {
+		t.Fatalf("item %d fail on resume: %s", r.Seq, r.Msg)
+	}
+	if stream.rep.Imported != 3 {
+		t.Fatalf("%d items imported after the resume, want 3", stream.rep.Imported)
+	}
+	// the first item is skipped and the third one replayed from its idempotency key
+	if n := adaptor.setCalls(); n != 3 {
+		t.Fatalf("%d keys stored after the resume, want 3", n)
+	}
+	if n, err := d.repo().CountKeysByUID(context.Background(), "wallet"); err != nil || n != 3 {
+		t.Fatalf("the wallet has %d keys, want 3, err %v", n, err)
+	}
+
+	ctx := context.Background()
+	if rep, err := d.GetImportCheckpoint(ctx, &keylocker.GetImportCheckpointReq{ImportId: "import"}); err != nil || rep.Code != keylocker.ReturnCode_ERROR {
+		t.Fatalf("get checkpoint without the admin token got %v %v, want ERROR", rep, err)
+	}
+	if rep, err := d.GetImportCheckpoint(ctx, &keylocker.GetImportCheckpointReq{ConsumerToken: testAdminToken, ImportId: "import"}); err != nil || rep.Checkpoint != 3 {
+		t.Fatalf("get checkpoint got %v %v, want 3", rep, err)
+	}
+}
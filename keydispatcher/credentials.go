@@ -0,0 +1,326 @@
+package keydispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/crypto"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/notify"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"gorm.io/gorm"
+)
+
+var (
+	errInvalidCredentials = errors.New("invalid password or social code")
+	errWalletNotFound     = errors.New("wallet not found")
+	errWalletLocked       = errors.New("too many wrong credentials, wallet locked")
+)
+
+const (
+	defaultMaxFailures = 5
+	defaultLockTime    = 900 * time.Second
+)
+
+// unlockWallet decrypts the rsa key pair of the wallet with the password and social code,
+// which are encrypted with the aes_key of the config like in SetSocialKeyReq
+func (d *Dispatcher) unlockWallet(ctx context.Context, walletUuid, password, socialCode string) (*crypto.Rsa, error) {
+	key, err := d.credentialKey(password, socialCode)
+	if err != nil {
+		return nil, err
+	}
+	sec, err := d.repo().GetByUID(ctx, walletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errWalletNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetByUID fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	return d.checkSecret(ctx, sec, key)
+}
+
+// checkSecret opens sec with the credential key, the wrong keys count towards the lock of the wallet
+func (d *Dispatcher) checkSecret(ctx context.Context, sec *model.Secret, key []byte) (*crypto.Rsa, error) {
+	now := time.Now()
+	if sec.Locked(now) {
+		return nil, fmt.Errorf("%w until %s", errWalletLocked, sec.LockedUntil.UTC().Format(time.RFC3339))
+	}
+	rsaObj, err := openSecret(sec, key)
+	if err != nil {
+		if e := d.countFailure(ctx, sec.KeyUuid, now); e != nil {
+			return nil, e
+		}
+		return nil, err
+	}
+	if sec.Failures > 0 {
+		if err := d.repo().ResetCredentialFailures(ctx, sec.KeyUuid); err != nil {
+			return nil, fmt.Errorf("repo.ResetCredentialFailures fail, walletUuid, %s, err: [%w]", sec.KeyUuid, err)
+		}
+	}
+	return rsaObj, nil
+}
+
+// countFailure counts wrong credentials given for the wallet and locks it after too many in a row
+func (d *Dispatcher) countFailure(ctx context.Context, walletUuid string, now time.Time) error {
+	conf := d.config().Credentials
+	maxFailures, lock := conf.MaxFailures, time.Duration(conf.LockTime)*time.Second
+	if maxFailures == 0 {
+		maxFailures = defaultMaxFailures
+	}
+	if lock <= 0 {
+		lock = defaultLockTime
+	}
+	lockedUntil, err := d.repo().AddCredentialFailure(ctx, walletUuid, maxFailures, lock, now)
+	if err != nil {
+		return fmt.Errorf("repo.AddCredentialFailure fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	if lockedUntil != nil {
+		log.Warn("wallet locked", "walletUuid", walletUuid, "until", lockedUntil)
+	}
+	return nil
+}
+
+// openSecret decrypts the rsa key pair of sec with the credential key
+func openSecret(sec *model.Secret, key []byte) (*crypto.Rsa, error) {
+	priv, err := crypto.AesDecrypt([]byte(sec.RsaPriv), key)
+	if err != nil {
+		return nil, errInvalidCredentials
+	}
+	rsaObj, err := crypto.LoadRsa(sec.RsaPub, string(priv))
+	if err != nil {
+		return nil, errInvalidCredentials
+	}
+	return rsaObj, nil
+}
+
+// credentialKey is the aes key the rsa private key of a wallet is encrypted with, the password
+// followed by the social code
+func (d *Dispatcher) credentialKey(password, socialCode string) ([]byte, error) {
+	pwd, scode, err := d.decryptCredentials(password, socialCode)
+	if err != nil {
+		return nil, err
+	}
+	return append(pwd, scode...), nil
+}
+
+// decryptCredentials decrypts the password and social code encrypted with the aes_key of the config
+func (d *Dispatcher) decryptCredentials(password, socialCode string) ([]byte, []byte, error) {
+	aesKey := []byte(d.config().AesKey)
+	pwd, err := crypto.AesDecrypt([]byte(password), aesKey)
+	if err != nil {
+		return nil, nil, errInvalidCredentials
+	}
+	scode, err := crypto.AesDecrypt([]byte(socialCode), aesKey)
+	if err != nil {
+		return nil, nil, errInvalidCredentials
+	}
+	return pwd, scode, nil
+}
+
+// ChangeCredentials re-encrypts the rsa private key of the wallet with the new password and social code,
+// the keys stored on the chains stay encrypted with the same rsa key pair
+func (d *Dispatcher) ChangeCredentials(ctx context.Context, req *keylocker.ChangeCredentialsReq) (*keylocker.ChangeCredentialsRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.ChangeCredentialsRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	key, err := d.credentialKey(req.Password, req.SocialCode)
+	if err != nil {
+		return &keylocker.ChangeCredentialsRep{
+			Code: keylocker.ReturnCode_INVALID_CREDENTIALS,
+			Msg:  err.Error(),
+		}, nil
+	}
+	newKey, err := d.credentialKey(req.NewPassword, req.NewSocialCode)
+	if err != nil {
+		return &keylocker.ChangeCredentialsRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "new_password and new_social_code must be encrypted with the aes_key",
+		}, nil
+	}
+	repo := d.repo()
+	sec, err := repo.GetByUID(ctx, req.WalletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &keylocker.ChangeCredentialsRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  errWalletNotFound.Error(),
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetByUID fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	rsaObj, err := d.checkSecret(ctx, sec, key)
+	if err == nil {
+		err = d.checkFrozen(ctx, req.WalletUuid)
+	}
+	if err == nil {
+		err = d.checkNewCredentials(req.WalletUuid, req.NewPassword, req.NewSocialCode)
+	}
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.ChangeCredentialsRep{
+			Code:       code,
+			Msg:        err.Error(),
+			Violations: policyViolations(err),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	newPriv, err := crypto.AesEncrypt([]byte(rsaObj.PrivateKey()), newKey)
+	if err != nil {
+		return &keylocker.ChangeCredentialsRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  fmt.Sprintf("new password and social code must be 16, 24 or 32 bytes together: %v", err),
+		}, nil
+	}
+	ok, err := repo.RewrapSecret(ctx, req.WalletUuid, sec.RsaPriv, string(newPriv), time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("repo.RewrapSecret fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	if !ok {
+		return &keylocker.ChangeCredentialsRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "credentials changed meanwhile, retry with the current ones",
+		}, nil
+	}
+	if err := d.audit(ctx, &model.AuditRecord{
+		Action:  model.AuditActionCredentials,
+		KeyUuid: req.WalletUuid,
+	}, map[string]interface{}{
+		"credential_version": sec.CredentialVersion + 1,
+	}); err != nil {
+		log.Error("audit credentials change fail", "walletUuid", req.WalletUuid, "err", err)
+	}
+	d.notify(&notify.Event{Type: notify.EventCredentialsChanged, WalletUuid: req.WalletUuid})
+	return &keylocker.ChangeCredentialsRep{
+		Code: keylocker.ReturnCode_SUCCESS,
+		Msg:  "change credentials success",
+	}, nil
+}
+
+// VerifyCredentials checks the credentials like a retrieval would, by unlocking the wallet and decrypting
+// its latest key, and only reports whether they are right
+func (d *Dispatcher) VerifyCredentials(ctx context.Context, req *keylocker.VerifyCredentialsReq) (*keylocker.VerifyCredentialsRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.VerifyCredentialsRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	adaptor, ok := d.acquire(req.Chain)
+	if !ok {
+		return &keylocker.VerifyCredentialsRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  config.UnsupportedOperation,
+		}, nil
+	}
+	defer adaptor.release()
+	rsaObj, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode)
+	if errors.Is(err, errWalletNotFound) {
+		return &keylocker.VerifyCredentialsRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  err.Error(),
+		}, nil
+	}
+	rep := &keylocker.VerifyCredentialsRep{
+		Code: keylocker.ReturnCode_SUCCESS,
+		Msg:  "credentials verified",
+	}
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		rep.Code, rep.Msg = code, err.Error()
+	} else if err != nil {
+		return nil, err
+	} else if rep, err = d.tryLatestKey(ctx, adaptor, rsaObj, req); err != nil {
+		return nil, err
+	}
+	if err := d.audit(ctx, &model.AuditRecord{
+		Action:  model.AuditActionVerifyCredentials,
+		KeyUuid: req.WalletUuid,
+		Chain:   req.Chain,
+	}, map[string]interface{}{
+		"verified": rep.Code == keylocker.ReturnCode_SUCCESS,
+		"code":     rep.Code.String(),
+	}); err != nil {
+		return nil, fmt.Errorf("audit fail, err: [%w]", err)
+	}
+	return rep, nil
+}
+
+// tryLatestKey decrypts the key stored last for the wallet on the chain, share keys included,
+// a wallet without keys only has its rsa key pair to verify
+func (d *Dispatcher) tryLatestKey(ctx context.Context, adaptor *adaptorEntry, rsaObj *crypto.Rsa, req *keylocker.VerifyCredentialsReq) (*keylocker.VerifyCredentialsRep, error) {
+	rows, err := d.repo().ListKeysByUID(ctx, req.WalletUuid, req.Chain)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListKeysByUID fail, req, %v, err: [%w]", req, err)
+	}
+	if len(rows) == 0 {
+		return &keylocker.VerifyCredentialsRep{
+			Code: keylocker.ReturnCode_SUCCESS,
+			Msg:  "credentials verified, no key stored to decrypt",
+		}, nil
+	}
+	latest := rows[len(rows)-1]
+	keyRep, err := adaptor.GetSocialKey(ctx, &keylocker.GetSocialKeyReq{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		WalletUuid:    req.WalletUuid,
+		KeyId:         latest.KeyId,
+		Version:       latest.Version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetSocialKey fail, req, %v, err: [%w]", req, err)
+	}
+	if keyRep.Code != keylocker.ReturnCode_SUCCESS || len(keyRep.KeyList) == 0 {
+		return &keylocker.VerifyCredentialsRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  fmt.Sprintf("read key %s version %d fail: %s", latest.KeyId, latest.Version, keyRep.Msg),
+		}, nil
+	}
+	plain, err := rsaObj.Decrypt([]byte(keyRep.KeyList[0].Key))
+	if err != nil {
+		return &keylocker.VerifyCredentialsRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  fmt.Sprintf("key %s version %d does not decrypt: %v", latest.KeyId, latest.Version, err),
+		}, nil
+	}
+	wipe(plain)
+	return &keylocker.VerifyCredentialsRep{
+		Code: keylocker.ReturnCode_SUCCESS,
+		Msg:  "credentials verified",
+	}, nil
+}
+
+// failureCode is the return code of the errors reported to the client, ERROR for the others
+func failureCode(err error) keylocker.ReturnCode {
+	var violated *policyError
+	switch {
+	case errors.Is(err, errInvalidCredentials):
+		return keylocker.ReturnCode_INVALID_CREDENTIALS
+	case errors.Is(err, errWalletNotFound):
+		return keylocker.ReturnCode_NOT_FOUND
+	case errors.Is(err, errApprovalRequired):
+		return keylocker.ReturnCode_APPROVAL_REQUIRED
+	case errors.Is(err, errWalletLocked):
+		return keylocker.ReturnCode_LOCKED
+	case errors.Is(err, errWalletFrozen):
+		return keylocker.ReturnCode_FROZEN
+	case errors.Is(err, errInvalidSignature):
+		return keylocker.ReturnCode_INVALID_CREDENTIALS
+	case errors.Is(err, errSessionRequired):
+		return keylocker.ReturnCode_SESSION_REQUIRED
+	case errors.Is(err, errTotpRequired):
+		return keylocker.ReturnCode_TOTP_REQUIRED
+	case errors.Is(err, errInvalidTotp):
+		return keylocker.ReturnCode_INVALID_CREDENTIALS
+	case errors.Is(err, errInvalidRecoveryCode):
+		return keylocker.ReturnCode_INVALID_CREDENTIALS
+	case errors.As(err, &violated):
+		return keylocker.ReturnCode_CREDENTIAL_POLICY
+	}
+	return keylocker.ReturnCode_ERROR
+}
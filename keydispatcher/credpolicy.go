@@ -0,0 +1,89 @@
+package keydispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/savour-labs/key-locker/policy"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"gorm.io/gorm"
+)
+
+// policyError is returned for credentials breaking the credential policy, the client gets its violations
+type policyError struct {
+	violations []policy.Violation
+}
+
+func (e *policyError) Error() string {
+	msgs := make([]string, len(e.violations))
+	for i, v := range e.violations {
+		msgs[i] = v.Message
+	}
+	return "credential policy: " + strings.Join(msgs, "; ")
+}
+
+// credentialPolicy is the credential policy of the config
+func (d *Dispatcher) credentialPolicy() *policy.Policy {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.credPolicy
+}
+
+// checkNewCredentials checks the password and social code a wallet is about to be encrypted with
+// against the credential policy, they are encrypted with the aes_key like in SetSocialKeyReq
+func (d *Dispatcher) checkNewCredentials(walletUuid, password, socialCode string) error {
+	pwd, scode, err := d.decryptCredentials(password, socialCode)
+	if err != nil {
+		return err
+	}
+	defer wipe(pwd)
+	defer wipe(scode)
+	if violations := d.credentialPolicy().CheckCredentials(string(pwd), string(scode), walletUuid); len(violations) > 0 {
+		return &policyError{violations: violations}
+	}
+	return nil
+}
+
+// checkStoreCredentials applies the credential policy to the credentials a key is stored with, the new credentials
+// of a wallet without rsa key pair yet are checked, the age of the current ones otherwise
+func (d *Dispatcher) checkStoreCredentials(ctx context.Context, walletUuid, password, socialCode string) error {
+	sec, err := d.repo().GetByUID(ctx, walletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err := d.checkNewCredentials(walletUuid, password, socialCode)
+		if errors.Is(err, errInvalidCredentials) {
+			// left to the backend, which reports credentials it can not decrypt
+			return nil
+		}
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("repo.GetByUID fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	if sec.RsaPriv == "" {
+		return nil
+	}
+	if v := d.credentialPolicy().CheckAge(sec.CredentialsSetAt(), time.Now()); v != nil {
+		return &policyError{violations: []policy.Violation{*v}}
+	}
+	return nil
+}
+
+// policyViolations are the violations of a policyError for the rep, nil for the other errors
+func policyViolations(err error) []*keylocker.PolicyViolation {
+	var pe *policyError
+	if !errors.As(err, &pe) {
+		return nil
+	}
+	res := make([]*keylocker.PolicyViolation, len(pe.violations))
+	for i, v := range pe.violations {
+		res[i] = &keylocker.PolicyViolation{
+			Rule:    v.Rule,
+			Message: v.Message,
+			Pattern: v.Pattern,
+		}
+	}
+	return res
+}
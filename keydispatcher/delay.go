@@ -0,0 +1,110 @@
+package keydispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/notify"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"gorm.io/gorm"
+)
+
+// SetRecoveryDelay sets the seconds a recovery case of the wallet waits before its keys can be released
+func (d *Dispatcher) SetRecoveryDelay(ctx context.Context, req *keylocker.SetRecoveryDelayReq) (*keylocker.SetRecoveryDelayRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.SetRecoveryDelayRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	if _, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode); err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.SetRecoveryDelayRep{
+				Code: code,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	delay, err := d.repo().SetRecoveryDelay(ctx, req.WalletUuid, req.Delay, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("repo.SetRecoveryDelay fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	d.notify(&notify.Event{Type: notify.EventDelayChanged, WalletUuid: req.WalletUuid})
+	rep := &keylocker.SetRecoveryDelayRep{
+		Code:         keylocker.ReturnCode_SUCCESS,
+		Msg:          "set recovery delay success",
+		Delay:        delay.Delay,
+		PendingDelay: delay.PendingDelay,
+	}
+	if delay.PendingAt != nil {
+		rep.PendingAt = delay.PendingAt.Unix()
+	}
+	return rep, nil
+}
+
+// CancelRecovery lets the owner cancel a recovery case of the wallet before it is released
+func (d *Dispatcher) CancelRecovery(ctx context.Context, req *keylocker.CancelRecoveryReq) (*keylocker.CancelRecoveryRep, error) {
+	repo := d.repo()
+	rc, err := repo.GetRecoveryCase(ctx, req.CaseId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &keylocker.CancelRecoveryRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  "recovery case not found",
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetRecoveryCase fail, req, %v, err: [%w]", req, err)
+	}
+	if _, err := d.unlockWallet(ctx, rc.KeyUuid, req.Password, req.SocialCode); err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.CancelRecoveryRep{
+				Code: code,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	if !model.CanTransition(rc.Status, model.RecoveryStatusCancelled) {
+		return &keylocker.CancelRecoveryRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  fmt.Sprintf("recovery case is %s", rc.Status),
+		}, nil
+	}
+	ok, err := repo.UpdateRecoveryStatus(ctx, rc.CaseId, rc.Status, model.RecoveryStatusCancelled)
+	if err != nil {
+		return nil, fmt.Errorf("repo.UpdateRecoveryStatus fail, req, %v, err: [%w]", req, err)
+	}
+	if !ok {
+		return &keylocker.CancelRecoveryRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "recovery case changed, retry",
+		}, nil
+	}
+	rc.Status = model.RecoveryStatusCancelled
+	d.notify(&notify.Event{Type: notify.EventRecoveryCancelled, WalletUuid: rc.KeyUuid, Chain: rc.Chain, CaseId: rc.CaseId})
+	info, err := d.recoveryCaseInfo(ctx, rc)
+	if err != nil {
+		return nil, err
+	}
+	return &keylocker.CancelRecoveryRep{
+		Code: keylocker.ReturnCode_SUCCESS,
+		Msg:  "cancel recovery success",
+		Case: info,
+	}, nil
+}
+
+// recoveryDelay returns the delay of the wallet in seconds, 0 when it has none
+func (d *Dispatcher) recoveryDelay(ctx context.Context, walletUuid string) (uint64, error) {
+	delay, err := d.repo().GetRecoveryDelay(ctx, walletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("repo.GetRecoveryDelay fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	return delay.Effective(time.Now()), nil
+}
@@ -0,0 +1,153 @@
+package keydispatcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+// DeleteSocialKey removes the keys of the wallet from the backend of the chain and leaves a tombstone
+// in the audit records. Once the wallet has no keys left on any chain its rsa key pair is soft deleted too.
+// It takes the credentials of the wallet or the admin token, and is refused while the wallet is frozen.
+func (d *Dispatcher) DeleteSocialKey(ctx context.Context, req *keylocker.DeleteSocialKeyReq) (*keylocker.DeleteSocialKeyRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.DeleteSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	err := d.authorizeDelete(ctx, req.ConsumerToken, req.WalletUuid, req.Password, req.SocialCode)
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.DeleteSocialKeyRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	adaptor, ok := d.acquire(req.Chain)
+	if !ok {
+		return &keylocker.DeleteSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  config.UnsupportedOperation,
+		}, nil
+	}
+	defer adaptor.release()
+	rep, err := adaptor.DeleteSocialKey(ctx, req)
+	if err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+		return rep, err
+	}
+
+	repo := d.repo()
+	if err := repo.DeleteInheritedKeys(ctx, req.WalletUuid, req.Chain); err != nil {
+		return nil, fmt.Errorf("repo.DeleteInheritedKeys fail, req, %v, err: [%w]", req, err)
+	}
+	if err := d.audit(ctx, &model.AuditRecord{
+		Action:  model.AuditActionDeleteSocialKey,
+		KeyUuid: req.WalletUuid,
+		Chain:   req.Chain,
+		Reason:  req.Reason,
+	}, map[string]interface{}{
+		"job_ids":   rep.JobIds,
+		"tx_hashes": rep.TxHashes,
+		"file_cids": rep.FileCids,
+	}); err != nil {
+		return nil, fmt.Errorf("audit fail, req, %v, err: [%w]", req, err)
+	}
+	left, err := repo.CountKeysByUID(ctx, req.WalletUuid)
+	if err != nil {
+		return nil, fmt.Errorf("repo.CountKeysByUID fail, req, %v, err: [%w]", req, err)
+	}
+	if left == 0 {
+		if err := repo.DeleteSecretByUID(ctx, req.WalletUuid); err != nil {
+			return nil, fmt.Errorf("repo.DeleteSecretByUID fail, req, %v, err: [%w]", req, err)
+		}
+		if err := repo.DeleteSharesByUID(ctx, req.WalletUuid); err != nil {
+			return nil, fmt.Errorf("repo.DeleteSharesByUID fail, req, %v, err: [%w]", req, err)
+		}
+		// nothing is left to inherit
+		if err := repo.DeleteInheritancePolicy(ctx, req.WalletUuid); err != nil {
+			return nil, fmt.Errorf("repo.DeleteInheritancePolicy fail, req, %v, err: [%w]", req, err)
+		}
+	}
+	return rep, nil
+}
+
+// PruneSocialKey removes the old versions of a key, the latest version is always kept.
+// Like DeleteSocialKey it takes the credentials of the wallet or the admin token.
+func (d *Dispatcher) PruneSocialKey(ctx context.Context, req *keylocker.PruneSocialKeyReq) (*keylocker.PruneSocialKeyRep, error) {
+	if req.WalletUuid == "" || req.KeyId == "" {
+		return &keylocker.PruneSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid and key_id are required",
+		}, nil
+	}
+	err := d.authorizeDelete(ctx, req.ConsumerToken, req.WalletUuid, req.Password, req.SocialCode)
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.PruneSocialKeyRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if share, err := d.isShareKey(ctx, req.WalletUuid, req.KeyId); err != nil {
+		return nil, err
+	} else if share {
+		return &keylocker.PruneSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "key holds shares, refresh the share set instead",
+		}, nil
+	}
+	adaptor, ok := d.acquire(req.Chain)
+	if !ok {
+		return &keylocker.PruneSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  config.UnsupportedOperation,
+		}, nil
+	}
+	defer adaptor.release()
+	rep, err := adaptor.PruneSocialKey(ctx, req)
+	if err != nil || rep.Code != keylocker.ReturnCode_SUCCESS || len(rep.PrunedVersions) == 0 {
+		return rep, err
+	}
+	if err := d.audit(ctx, &model.AuditRecord{
+		Action:  model.AuditActionPruneSocialKey,
+		KeyUuid: req.WalletUuid,
+		Chain:   req.Chain,
+	}, map[string]interface{}{
+		"key_id":          req.KeyId,
+		"pruned_versions": rep.PrunedVersions,
+		"job_ids":         rep.JobIds,
+		"tx_hashes":       rep.TxHashes,
+		"file_cids":       rep.FileCids,
+	}); err != nil {
+		return nil, fmt.Errorf("audit fail, req, %v, err: [%w]", req, err)
+	}
+	return rep, nil
+}
+
+// authorizeDelete checks the credentials of the wallet, unless the consumer token is the admin token,
+// and that the wallet is not frozen
+func (d *Dispatcher) authorizeDelete(ctx context.Context, consumerToken, walletUuid, password, socialCode string) error {
+	if !d.isAdmin(consumerToken) {
+		if _, err := d.unlockWallet(ctx, walletUuid, password, socialCode); err != nil {
+			return err
+		}
+	}
+	return d.checkFrozen(ctx, walletUuid)
+}
+
+// audit stores the record with detail encoded as json
+func (d *Dispatcher) audit(ctx context.Context, record *model.AuditRecord, detail map[string]interface{}) error {
+	raw, err := json.Marshal(detail)
+	if err != nil {
+		return err
+	}
+	record.Detail = string(raw)
+	return d.repo().CreateAuditRecord(ctx, record)
+}
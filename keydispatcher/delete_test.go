@@ -0,0 +1,61 @@
+package keydispatcher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+func TestDeleteSocialKeyRequiresCredentials(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	ctx := context.Background()
+	storeTestKey(t, d, "wallet", "seed phrase")
+
+	for name, req := range map[string]*keylocker.DeleteSocialKeyReq{
+		"no credentials":    {ConsumerToken: "consumer", Chain: testChain, WalletUuid: "wallet"},
+		"wrong credentials": {Chain: testChain, WalletUuid: "wallet", Password: sealed(t, "Wrong#pass1"), SocialCode: sealed(t, "000000")},
+	} {
+		rep, err := d.DeleteSocialKey(ctx, req)
+		if err != nil || rep.Code != keylocker.ReturnCode_INVALID_CREDENTIALS {
+			t.Fatalf("%s: delete got %v %v, want INVALID_CREDENTIALS", name, rep, err)
+		}
+	}
+	prune, err := d.PruneSocialKey(ctx, &keylocker.PruneSocialKeyReq{Chain: testChain, WalletUuid: "wallet", KeyId: "1"})
+	if err != nil || prune.Code != keylocker.ReturnCode_INVALID_CREDENTIALS {
+		t.Fatalf("prune got %v %v, want INVALID_CREDENTIALS", prune, err)
+	}
+	if n, err := d.repo().CountKeysByUID(ctx, "wallet"); err != nil || n != 1 {
+		t.Fatalf("the wallet has %d keys left, want 1, err %v", n, err)
+	}
+
+	rep, err := d.DeleteSocialKey(ctx, &keylocker.DeleteSocialKeyReq{
+		Chain:      testChain,
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+	})
+	if err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("delete with credentials fail: %v %v", rep, err)
+	}
+	if n, err := d.repo().CountKeysByUID(ctx, "wallet"); err != nil || n != 0 {
+		t.Fatalf("the wallet has %d keys left, want 0, err %v", n, err)
+	}
+}
+
+func TestDeleteSocialKeyRefusedWhileFrozen(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	ctx := context.Background()
+	storeTestKey(t, d, "wallet", "seed phrase")
+	if err := d.repo().FreezeWallet(ctx, "wallet", "stolen phone"); err != nil {
+		t.Fatal(err)
+	}
+
+	rep, err := d.DeleteSocialKey(ctx, &keylocker.DeleteSocialKeyReq{ConsumerToken: testAdminToken, Chain: testChain, WalletUuid: "wallet"})
+	if err != nil || rep.Code != keylocker.ReturnCode_FROZEN {
+		t.Fatalf("delete got %v %v, want FROZEN", rep, err)
+	}
+	if n, err := d.repo().CountKeysByUID(ctx, "wallet"); err != nil || n != 1 {
+		t.Fatalf("the wallet has %d keys left, want 1, err %v", n, err)
+	}
+}
@@ -0,0 +1,272 @@
+package keydispatcher
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/crypto"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+// SLIP-44 coin types with a known address format
+const (
+	CoinTypeBitcoin        uint32 = 0
+	CoinTypeBitcoinTestnet uint32 = 1
+	CoinTypeEthereum       uint32 = 60
+)
+
+const (
+	// maxDeriveQueries bounds the accounts plus the paths of one request
+	maxDeriveQueries = 20
+	// maxDeriveCount bounds the addresses derived for one account
+	maxDeriveCount = 100
+)
+
+// DeriveAccounts derives the xpubs and addresses of the BIP39 mnemonic stored as the key of the wallet,
+// the mnemonic is decrypted in memory and wiped once derived
+func (d *Dispatcher) DeriveAccounts(ctx context.Context, req *keylocker.DeriveAccountsReq) (*keylocker.DeriveAccountsRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.DeriveAccountsRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	paths, err := checkDeriveQueries(req)
+	if err != nil {
+		return &keylocker.DeriveAccountsRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, nil
+	}
+	rsaObj, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode)
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.DeriveAccountsRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := d.checkFactors(ctx, req.WalletUuid, factors{sessionToken: req.SessionToken, totpCode: req.TotpCode, recoveryCode: req.RecoveryCode}); err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.DeriveAccountsRep{
+				Code: code,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	adaptor, ok := d.acquire(req.Chain)
+	if !ok {
+		return &keylocker.DeriveAccountsRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  config.UnsupportedOperation,
+		}, nil
+	}
+	defer adaptor.release()
+	rc, err := d.claimRecovery(ctx, req.WalletUuid, req.Chain, req.RecoveryCaseId)
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.DeriveAccountsRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	keyRep, err := d.getSocialKey(ctx, adaptor, &keylocker.GetSocialKeyReq{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		WalletUuid:    req.WalletUuid,
+		KeyId:         req.KeyId,
+		Version:       req.Version,
+	})
+	var rep *keylocker.DeriveAccountsRep
+	switch {
+	case err != nil:
+	case keyRep.Code != keylocker.ReturnCode_SUCCESS:
+		rep = &keylocker.DeriveAccountsRep{Code: keyRep.Code, Msg: keyRep.Msg}
+	case len(keyRep.KeyList) == 0:
+		rep = &keylocker.DeriveAccountsRep{Code: keylocker.ReturnCode_NOT_FOUND, Msg: "no social key stored"}
+	case len(keyRep.KeyList) > 1:
+		rep = &keylocker.DeriveAccountsRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  fmt.Sprintf("key_id is required, the wallet has %d keys", len(keyRep.KeyList)),
+		}
+	default:
+		plain, decErr := rsaObj.Decrypt([]byte(keyRep.KeyList[0].Key))
+		if decErr != nil {
+			rep = &keylocker.DeriveAccountsRep{
+				Code: keylocker.ReturnCode_ERROR,
+				Msg:  fmt.Sprintf("decrypt key fail: %v", decErr),
+			}
+			break
+		}
+		rep, err = d.deriveAccounts(ctx, req, keyRep.KeyList[0].Id, paths, plain)
+	}
+	d.settleRecovery(ctx, rc, err == nil && rep.Code == keylocker.ReturnCode_SUCCESS)
+	return rep, err
+}
+
+// deriveAccounts derives the queries of req from the mnemonic, plain is wiped before returning
+func (d *Dispatcher) deriveAccounts(ctx context.Context, req *keylocker.DeriveAccountsReq, keyId string, paths []accounts.DerivationPath, plain []byte) (*keylocker.DeriveAccountsRep, error) {
+	defer wipe(plain)
+	master, err := crypto.NewHDKeyFromMnemonic(string(bytes.TrimSpace(plain)), req.Passphrase)
+	if errors.Is(err, crypto.ErrInvalidMnemonic) {
+		return &keylocker.DeriveAccountsRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "stored key is not a bip39 mnemonic",
+		}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("NewHDKeyFromMnemonic fail, err: [%w]", err)
+	}
+	defer master.Wipe()
+
+	rep := &keylocker.DeriveAccountsRep{
+		Code: keylocker.ReturnCode_SUCCESS,
+		Msg:  "derive accounts success",
+	}
+	for _, q := range req.Accounts {
+		path := accounts.DerivationPath{
+			crypto.HardenedOffset + 44,
+			crypto.HardenedOffset + q.CoinType,
+			crypto.HardenedOffset + q.Account,
+		}
+		account, err := deriveKey(master, path)
+		if err != nil {
+			return nil, err
+		}
+		version := crypto.XPubVersion
+		if q.CoinType == CoinTypeBitcoinTestnet {
+			version = crypto.TPubVersion
+		}
+		res := &keylocker.DerivedAccount{
+			Path:     path.String(),
+			CoinType: q.CoinType,
+			Account:  q.Account,
+			Xpub:     account.PublicString(version),
+		}
+		rep.Accounts = append(rep.Accounts, res)
+		if q.Count == 0 {
+			continue
+		}
+		change, err := account.Child(q.Change)
+		if err != nil {
+			return nil, fmt.Errorf("derive %s/%d fail, err: [%w]", path, q.Change, err)
+		}
+		for index := q.Start; index < q.Start+q.Count; index++ {
+			child, err := change.Child(index)
+			if err != nil {
+				return nil, fmt.Errorf("derive %s/%d/%d fail, err: [%w]", path, q.Change, index, err)
+			}
+			addr, err := derivedAddress(append(path[:3:3], q.Change, index), child)
+			if err != nil {
+				return nil, err
+			}
+			res.Addresses = append(res.Addresses, addr)
+		}
+	}
+	for _, path := range paths {
+		key, err := deriveKey(master, path)
+		if err != nil {
+			return nil, err
+		}
+		addr, err := derivedAddress(path, key)
+		if err != nil {
+			return nil, err
+		}
+		rep.Addresses = append(rep.Addresses, addr)
+	}
+
+	if err := d.audit(ctx, &model.AuditRecord{
+		Action:  model.AuditActionDeriveAccounts,
+		KeyUuid: req.WalletUuid,
+		Chain:   req.Chain,
+	}, map[string]interface{}{
+		"key_id":    keyId,
+		"accounts":  len(rep.Accounts),
+		"addresses": len(rep.Addresses),
+	}); err != nil {
+		return nil, fmt.Errorf("audit fail, err: [%w]", err)
+	}
+	return rep, nil
+}
+
+// deriveKey returns the public key of the descendant of master along path
+func deriveKey(master *crypto.HDKey, path accounts.DerivationPath) (*crypto.HDKey, error) {
+	key, err := master.Derive(path)
+	if err != nil {
+		return nil, fmt.Errorf("derive %s fail, err: [%w]", path, err)
+	}
+	defer key.Wipe()
+	return key.Neuter(), nil
+}
+
+// derivedAddress formats the address of the coin type of path, BIP44 P2PKH for bitcoin
+func derivedAddress(path accounts.DerivationPath, key *crypto.HDKey) (*keylocker.DerivedAddress, error) {
+	res := &keylocker.DerivedAddress{
+		Path:      path.String(),
+		PublicKey: hex.EncodeToString(key.PublicKey()),
+	}
+	if len(path) < 2 {
+		return res, nil
+	}
+	switch path[1] {
+	case crypto.HardenedOffset + CoinTypeEthereum:
+		pub, err := key.ECDSAPublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("ECDSAPublicKey fail, err: [%w]", err)
+		}
+		res.Address = ethcrypto.PubkeyToAddress(*pub).Hex()
+	case crypto.HardenedOffset + CoinTypeBitcoin:
+		if path[0] == crypto.HardenedOffset+44 {
+			res.Address = crypto.P2PKHAddress(key.PublicKey(), crypto.BtcP2PKHVersion)
+		}
+	case crypto.HardenedOffset + CoinTypeBitcoinTestnet:
+		if path[0] == crypto.HardenedOffset+44 {
+			res.Address = crypto.P2PKHAddress(key.PublicKey(), crypto.BtcTestP2PKHVersion)
+		}
+	}
+	return res, nil
+}
+
+// checkDeriveQueries validates the accounts of req and parses its paths
+func checkDeriveQueries(req *keylocker.DeriveAccountsReq) ([]accounts.DerivationPath, error) {
+	if len(req.Accounts)+len(req.Paths) == 0 {
+		return nil, errors.New("accounts or paths is required")
+	}
+	if len(req.Accounts)+len(req.Paths) > maxDeriveQueries {
+		return nil, fmt.Errorf("at most %d accounts and paths can be derived at once", maxDeriveQueries)
+	}
+	for _, q := range req.Accounts {
+		if q.CoinType >= crypto.HardenedOffset || q.Account >= crypto.HardenedOffset || q.Change >= crypto.HardenedOffset {
+			return nil, fmt.Errorf("coin_type, account and change must be below %d", crypto.HardenedOffset)
+		}
+		if q.Count > maxDeriveCount {
+			return nil, fmt.Errorf("count must be at most %d", maxDeriveCount)
+		}
+		if uint64(q.Start)+uint64(q.Count) > uint64(crypto.HardenedOffset) {
+			return nil, fmt.Errorf("start+count must be at most %d", crypto.HardenedOffset)
+		}
+	}
+	paths := make([]accounts.DerivationPath, 0, len(req.Paths))
+	for _, p := range req.Paths {
+		if !strings.HasPrefix(strings.TrimSpace(p), "m/") {
+			return nil, fmt.Errorf("invalid path %q, an absolute path starting with m/ is required", p)
+		}
+		path, err := accounts.ParseDerivationPath(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path %q: %v", p, err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
@@ -2,16 +2,16 @@ package keydispatcher
 
 import (
 	"context"
-	"github.com/savour-labs/key-locker/blockchain/moonbeam"
+	"fmt"
 	"runtime/debug"
 	"strings"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/log"
-	"github.com/savour-labs/key-locker/blockchain"
-	"github.com/savour-labs/key-locker/blockchain/ethereum"
-	"github.com/savour-labs/key-locker/blockchain/filecoin"
-	"github.com/savour-labs/key-locker/blockchain/ipfs"
 	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/db"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/policy"
 	"github.com/savour-labs/key-locker/proto/keylocker"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -24,30 +24,58 @@ type CommonRequest interface {
 
 type ChainType = string
 
+// sensitiveMethods return plaintext keys or secrets, their responses are never logged
+var sensitiveMethods = map[string]bool{
+	"recoverSocialKey":      true,
+	"getShare":              true,
+	"login":                 true,
+	"enrollTotp":            true,
+	"confirmTotp":           true,
+	"generateRecoveryCodes": true,
+	"issueSocialCode":       true,
+}
+
+// sensitiveRequests carry plaintext shares or passphrases, the requests are never logged
+var sensitiveRequests = map[string]bool{
+	"storeShares":    true,
+	"refreshShares":  true,
+	"deriveAccounts": true,
+}
+
 type Dispatcher struct {
-	registry map[ChainType]blockchain.KeyAdaptor
+	mu       sync.RWMutex
+	reloadMu sync.Mutex
+	conf     *config.Config
+	keyRepo  *model.Repo
+	registry map[ChainType]*adaptorEntry
+	// requests counts the requests using the repo, a repo swapped out is closed once they drained
+	requests *requestGen
+	// credPolicy is the credential policy of conf, with its banned passwords loaded
+	credPolicy *policy.Policy
 }
 
 func New(conf *config.Config) (*Dispatcher, error) {
-	dispatcher := Dispatcher{
-		registry: make(map[ChainType]blockchain.KeyAdaptor),
+	credPolicy, err := policy.Load(conf.Credentials)
+	if err != nil {
+		return nil, fmt.Errorf("load credential policy fail, err: [%w]", err)
 	}
-	keyAdaptorFactoryMap := map[string]func(conf *config.Config) (blockchain.KeyAdaptor, error){
-		ethereum.ChainName: ethereum.NewChainAdaptor,
-		moonbeam.ChainName: moonbeam.NewChainAdaptor,
-		ipfs.ChainName:     ipfs.NewChainAdaptor,
-		filecoin.ChainName: filecoin.NewChainAdaptor,
+	dispatcher := Dispatcher{
+		conf:       conf,
+		keyRepo:    model.NewRepo(db.InitDB(conf.Database)),
+		registry:   make(map[ChainType]*adaptorEntry),
+		requests:   newRequestGen(nil),
+		credPolicy: credPolicy,
 	}
-	supportedChains := []string{ethereum.ChainName, moonbeam.ChainName, ipfs.ChainName, filecoin.ChainName}
+	registerPlugins(conf.PluginDir)
 	for _, c := range conf.Chains {
-		if factory, ok := keyAdaptorFactoryMap[c]; ok {
+		if factory, ok := lookupFactory(c); ok {
 			adaptor, err := factory(conf)
 			if err != nil {
 				log.Crit("failed to setup chain", "chain", c, "error", err)
 			}
-			dispatcher.registry[c] = adaptor
+			dispatcher.registry[c] = newAdaptorEntry(adaptor, adaptorConfig(conf, c))
 		} else {
-			log.Error("unsupported chain", "chain", c, "supportedChains", supportedChains)
+			log.Error("unsupported chain", "chain", c, "supportedChains", supportedChains())
 		}
 	}
 	return &dispatcher, nil
@@ -61,56 +89,176 @@ func (d *Dispatcher) Interceptor(ctx context.Context, req interface{}, info *grp
 			err = status.Errorf(codes.Internal, "Panic err: %v", e)
 		}
 	}()
+	defer d.begin()()
 	pos := strings.LastIndex(info.FullMethod, "/")
 	method := info.FullMethod[pos+1:]
-	chain := req.(CommonRequest).GetChain()
-	log.Info(method, "chain", chain, "req", req)
+	chain := ""
+	if r, ok := req.(CommonRequest); ok {
+		chain = r.GetChain()
+	}
+	if sensitiveRequests[method] {
+		log.Info(method, "chain", chain)
+	} else {
+		log.Info(method, "chain", chain, "req", req)
+	}
 	resp, err = handler(ctx, req)
+	if sensitiveMethods[method] {
+		log.Debug("Finish handling", "method", method, "err", err)
+		return
+	}
 	log.Debug("Finish handling", "resp", resp, "err", err)
 	return
 }
 
-func (d *Dispatcher) preHandler(req interface{}) (resp *keylocker.SupportChainRep) {
-	chain := req.(CommonRequest).GetChain()
-	if _, ok := d.registry[chain]; !ok {
-		return &keylocker.SupportChainRep{
-			Code:    keylocker.ReturnCode_ERROR,
-			Msg:     config.UnsupportedOperation,
-			Support: false,
+func (d *Dispatcher) config() *config.Config {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.conf
+}
+
+func (d *Dispatcher) repo() *model.Repo {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.keyRepo
+}
+
+func (d *Dispatcher) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			log.Error("panic error", "msg", e)
+			log.Debug(string(debug.Stack()))
+			err = status.Errorf(codes.Internal, "Panic err: %v", e)
 		}
+	}()
+	defer d.begin()()
+	pos := strings.LastIndex(info.FullMethod, "/")
+	method := info.FullMethod[pos+1:]
+	log.Info(method, "clientStream", info.IsClientStream, "serverStream", info.IsServerStream)
+	err = handler(srv, ss)
+	log.Debug("Finish handling", "method", method, "err", err)
+	return
+}
+
+// acquire returns the adaptor registered for the chain and marks a request in flight on it,
+// the caller must call release on the returned entry once the request is done
+func (d *Dispatcher) acquire(chain string) (*adaptorEntry, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	entry, ok := d.registry[chain]
+	if !ok {
+		return nil, false
 	}
-	return nil
+	entry.inflight.Add(1)
+	return entry, true
 }
 
 func (d *Dispatcher) GetSupportChain(ctx context.Context, req *keylocker.SupportChainReq) (*keylocker.SupportChainRep, error) {
-	resp := d.preHandler(req)
-	if resp != nil {
+	adaptor, ok := d.acquire(req.Chain)
+	if !ok {
 		return &keylocker.SupportChainRep{
-			Code: keylocker.ReturnCode_ERROR,
-			Msg:  config.UnsupportedOperation,
+			Code:    keylocker.ReturnCode_ERROR,
+			Msg:     config.UnsupportedOperation,
+			Support: false,
 		}, nil
 	}
-	return d.registry[req.Chain].GetSupportChain(req)
+	defer adaptor.release()
+	return adaptor.GetSupportChain(req)
 }
 
 func (d *Dispatcher) SetSocialKey(ctx context.Context, req *keylocker.SetSocialKeyReq) (*keylocker.SetSocialKeyRep, error) {
-	resp := d.preHandler(req)
-	if resp != nil {
+	if req.IdempotencyKey != "" {
+		return d.setSocialKeyOnce(ctx, req)
+	}
+	return d.setSocialKey(ctx, req)
+}
+
+func (d *Dispatcher) setSocialKey(ctx context.Context, req *keylocker.SetSocialKeyReq) (*keylocker.SetSocialKeyRep, error) {
+	adaptor, ok := d.acquire(req.Chain)
+	if !ok {
 		return &keylocker.SetSocialKeyRep{
 			Code: keylocker.ReturnCode_ERROR,
 			Msg:  config.UnsupportedOperation,
 		}, nil
 	}
-	return d.registry[req.Chain].SetSocialKey(ctx, req)
+	defer adaptor.release()
+	if err := d.checkStoreCredentials(ctx, req.WalletUuid, req.Password, req.SocialCode); err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.SetSocialKeyRep{
+				Code:       code,
+				Msg:        err.Error(),
+				Violations: policyViolations(err),
+			}, nil
+		}
+		return nil, err
+	}
+	var rep *keylocker.SetSocialKeyRep
+	err := d.withSocialCode(ctx, req.WalletUuid, req.SocialCode, func() (bool, error) {
+		var err error
+		rep, err = adaptor.SetSocialKey(ctx, req)
+		return err == nil && rep.Code == keylocker.ReturnCode_SUCCESS, err
+	})
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.SetSocialKeyRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if rep.Code == keylocker.ReturnCode_SUCCESS {
+		// the key is stored already, a retry would store it again
+		if err := d.inheritKey(ctx, req.WalletUuid, req.Chain, rep.KeyId, rep.Version, []byte(req.Key)); err != nil {
+			log.Error("hold key for the beneficiary fail", "walletUuid", req.WalletUuid, "keyId", rep.KeyId, "err", err)
+		}
+	}
+	return rep, nil
 }
 
 func (d *Dispatcher) GetSocialKey(ctx context.Context, req *keylocker.GetSocialKeyReq) (*keylocker.GetSocialKeyRep, error) {
-	resp := d.preHandler(req)
-	if resp != nil {
+	adaptor, ok := d.acquire(req.Chain)
+	if !ok {
 		return &keylocker.GetSocialKeyRep{
 			Code: keylocker.ReturnCode_ERROR,
 			Msg:  config.UnsupportedOperation,
 		}, nil
 	}
-	return d.registry[req.Chain].GetSocialKey(ctx, req)
+	defer adaptor.release()
+	if err := d.checkFactors(ctx, req.WalletUuid, factors{sessionToken: req.SessionToken, totpCode: req.TotpCode, recoveryCode: req.RecoveryCode}); err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.GetSocialKeyRep{
+				Code: code,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	rc, err := d.claimRecovery(ctx, req.WalletUuid, req.Chain, req.RecoveryCaseId)
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.GetSocialKeyRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	rep, err := d.getSocialKey(ctx, adaptor, req)
+	d.settleRecovery(ctx, rc, err == nil && rep.Code == keylocker.ReturnCode_SUCCESS)
+	return rep, err
+}
+
+func (d *Dispatcher) getSocialKey(ctx context.Context, adaptor *adaptorEntry, req *keylocker.GetSocialKeyReq) (*keylocker.GetSocialKeyRep, error) {
+	rep, err := adaptor.GetSocialKey(ctx, req)
+	if err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+		return rep, err
+	}
+	if rep.KeyList, err = d.dropShares(ctx, req.WalletUuid, rep.KeyList); err != nil {
+		return nil, err
+	}
+	if req.KeyId != "" && len(rep.KeyList) == 0 {
+		return &keylocker.GetSocialKeyRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  "key not found",
+		}, nil
+	}
+	return rep, nil
 }
@@ -0,0 +1,204 @@
+package keydispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/crypto"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const (
+	testChain      = "Memory"
+	testAesKey     = "0123456789abcdef"
+	testAdminToken = "admin-token"
+	// the password and social code make a 16 bytes aes key together
+	testPassword   = "Zq8#vLm2pX"
+	testSocialCode = "482913"
+)
+
+// memoryAdaptor keeps the keys in memory, it creates and unlocks the rsa key pair of a wallet like the chain adaptors
+type memoryAdaptor struct {
+	repo *model.Repo
+
+	mu     sync.Mutex
+	stored map[uint][]byte
+	sets   int
+}
+
+func (a *memoryAdaptor) GetSupportChain(req *keylocker.SupportChainReq) (*keylocker.SupportChainRep, error) {
+	return &keylocker.SupportChainRep{Code: keylocker.ReturnCode_SUCCESS, Support: req.Chain == testChain}, nil
+}
+
+func (a *memoryAdaptor) SetSocialKey(ctx context.Context, req *keylocker.SetSocialKeyReq) (*keylocker.SetSocialKeyRep, error) {
+	pwd, err := crypto.AesDecrypt([]byte(req.Password), []byte(testAesKey))
+	if err != nil {
+		return nil, fmt.Errorf("decrypt password fail err: [%w]", err)
+	}
+	scode, err := crypto.AesDecrypt([]byte(req.SocialCode), []byte(testAesKey))
+	if err != nil {
+		return nil, fmt.Errorf("decrypt social code fail err: [%w]", err)
+	}
+	credKey := append(pwd, scode...)
+	var pri, pub, encryptPriv string
+	sec, err := a.repo.GetByUID(ctx, req.WalletUuid)
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		pri, pub = crypto.NewRsa("", "").CreatePkcs8Keys(1024)
+		raw, err := crypto.AesEncrypt([]byte(pri), credKey)
+		if err != nil {
+			return nil, err
+		}
+		encryptPriv = string(raw)
+		if err := a.repo.DB.Create(&model.Secret{KeyUuid: req.WalletUuid, RsaPriv: encryptPriv, RsaPub: pub}).Error; err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case sec.RsaPriv == "":
+		pub = sec.RsaPub
+	default:
+		raw, err := crypto.AesDecrypt([]byte(sec.RsaPriv), credKey)
+		if err != nil {
+			return nil, fmt.Errorf("crypto.DecryptByAes fail, err: [%w]", err)
+		}
+		pri, pub, encryptPriv = string(raw), sec.RsaPub, sec.RsaPriv
+	}
+	key, err := crypto.NewRsa(pub, pri).Encrypt([]byte(req.Key))
+	if err != nil {
+		return nil, err
+	}
+	row := &model.Key{KeyUuid: req.WalletUuid, Chain: testChain, Scheme: crypto.RsaScheme, KeyId: req.KeyId, Label: req.Label}
+	if err := a.repo.CreateKeyVersion(ctx, row); errors.Is(err, model.ErrKeyNotFound) {
+		return &keylocker.SetSocialKeyRep{Code: keylocker.ReturnCode_NOT_FOUND, Msg: err.Error()}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	a.mu.Lock()
+	a.stored[row.ID] = key
+	a.sets++
+	a.mu.Unlock()
+	return &keylocker.SetSocialKeyRep{
+		Code:    keylocker.ReturnCode_SUCCESS,
+		Msg:     "set social key success",
+		Pub:     pub,
+		Priv:    encryptPriv,
+		KeyId:   row.KeyId,
+		Version: row.Version,
+	}, nil
+}
+
+func (a *memoryAdaptor) GetSocialKey(ctx context.Context, req *keylocker.GetSocialKeyReq) (*keylocker.GetSocialKeyRep, error) {
+	rows, err := a.repo.SelectKeys(ctx, req.WalletUuid, testChain, req.KeyId, req.Version)
+	if errors.Is(err, model.ErrKeyNotFound) {
+		return &keylocker.GetSocialKeyRep{Code: keylocker.ReturnCode_NOT_FOUND, Msg: err.Error()}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	rep := &keylocker.GetSocialKeyRep{Code: keylocker.ReturnCode_SUCCESS, Msg: "get social key success"}
+	for _, row := range rows {
+		rep.KeyList = append(rep.KeyList, &keylocker.SocialKey{Id: row.KeyId, Key: string(a.stored[row.ID]), Label: row.Label, Version: row.Version})
+	}
+	return rep, nil
+}
+
+func (a *memoryAdaptor) DeleteSocialKey(ctx context.Context, req *keylocker.DeleteSocialKeyReq) (*keylocker.DeleteSocialKeyRep, error) {
+	if err := a.repo.DeleteKeysByUID(ctx, req.WalletUuid, testChain); err != nil {
+		return nil, err
+	}
+	return &keylocker.DeleteSocialKeyRep{Code: keylocker.ReturnCode_SUCCESS, Msg: "delete social key success"}, nil
+}
+
+func (a *memoryAdaptor) PruneSocialKey(ctx context.Context, req *keylocker.PruneSocialKeyReq) (*keylocker.PruneSocialKeyRep, error) {
+	versions, err := a.repo.ListKeyVersions(ctx, req.WalletUuid, testChain, req.KeyId)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return &keylocker.PruneSocialKeyRep{Code: keylocker.ReturnCode_NOT_FOUND, Msg: model.ErrKeyNotFound.Error()}, nil
+	}
+	rep := &keylocker.PruneSocialKeyRep{Code: keylocker.ReturnCode_SUCCESS, Msg: "prune social key success"}
+	var ids []uint
+	for _, k := range model.PrunableVersions(versions, req.BeforeVersion) {
+		ids = append(ids, k.ID)
+		rep.PrunedVersions = append(rep.PrunedVersions, k.Version)
+	}
+	return rep, a.repo.DeleteKeys(ctx, ids)
+}
+
+func (a *memoryAdaptor) setCalls() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.sets
+}
+
+// newTestDispatcher returns a dispatcher on an in-memory sqlite database with the memory adaptor registered
+func newTestDispatcher(t *testing.T) (*Dispatcher, *memoryAdaptor) {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("open sqlite fail: %v", err)
+	}
+	if err := db.AutoMigrate(
+		&model.Key{}, &model.Secret{}, &model.ImportCheckpoint{}, &model.IdempotencyRecord{}, &model.Job{},
+		&model.AuditRecord{}, &model.RecoveryPolicy{}, &model.Guardian{}, &model.GuardianChange{}, &model.RecoveryCase{},
+		&model.RecoveryApproval{}, &model.RecoveryDelay{}, &model.InheritancePolicy{}, &model.InheritedKey{},
+		&model.ShareSet{}, &model.Share{}, &model.WalletFreeze{}, &model.LoginChallenge{}, &model.Session{},
+		&model.TotpSecret{}, &model.OneTimeCode{}, &model.IssuedSocialCode{},
+	); err != nil {
+		t.Fatalf("migrate sqlite fail: %v", err)
+	}
+	repo := model.NewRepo(db)
+	t.Cleanup(func() { _ = repo.Close() })
+	adaptor := &memoryAdaptor{repo: repo, stored: make(map[uint][]byte)}
+	d := &Dispatcher{
+		conf: &config.Config{
+			AesKey:    testAesKey,
+			Chains:    []string{testChain},
+			RpcServer: &config.RpcServer{AdminToken: testAdminToken},
+		},
+		keyRepo:  repo,
+		registry: map[ChainType]*adaptorEntry{testChain: newAdaptorEntry(adaptor, adaptorConf{})},
+		requests: newRequestGen(nil),
+	}
+	return d, adaptor
+}
+
+// sealed encrypts a password or social code with the aes_key like the clients do
+func sealed(t *testing.T, plain string) string {
+	t.Helper()
+	raw, err := crypto.AesEncrypt([]byte(plain), []byte(testAesKey))
+	if err != nil {
+		t.Fatalf("encrypt credential fail: %v", err)
+	}
+	return string(raw)
+}
+
+// storeTestKey stores a key for the wallet with the test credentials, creating the wallet on the first key
+func storeTestKey(t *testing.T, d *Dispatcher, walletUuid, key string) *keylocker.SetSocialKeyRep {
+	t.Helper()
+	rep, err := d.SetSocialKey(context.Background(), &keylocker.SetSocialKeyReq{
+		Chain:      testChain,
+		WalletUuid: walletUuid,
+		Key:        key,
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+	})
+	if err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("store key fail: %v %v", rep, err)
+	}
+	return rep
+}
@@ -0,0 +1,39 @@
+package keydispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// factors are what the retrievals are given besides the credentials
+type factors struct {
+	sessionToken string
+	totpCode     string
+	recoveryCode string
+}
+
+// checkFactors requires the factors the wallet enabled for its retrievals, a wallet without a rsa key pair has none.
+// A recovery code replaces the totp code only, the session is still required.
+func (d *Dispatcher) checkFactors(ctx context.Context, walletUuid string, f factors) error {
+	sec, err := d.repo().GetByUID(ctx, walletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("repo.GetByUID fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	if sec.Locked(time.Now()) {
+		return fmt.Errorf("%w until %s", errWalletLocked, sec.LockedUntil.UTC().Format(time.RFC3339))
+	}
+	if err := d.checkSession(ctx, sec, f.sessionToken); err != nil {
+		return err
+	}
+	if f.recoveryCode != "" {
+		return d.redeemRecoveryCode(ctx, walletUuid, f.recoveryCode)
+	}
+	return d.checkTotp(ctx, walletUuid, f.totpCode)
+}
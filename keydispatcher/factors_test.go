@@ -0,0 +1,20 @@
+package keydispatcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRecoveryCodeStillNeedsSession(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	ctx := context.Background()
+	storeTestKey(t, d, "wallet", "seed phrase")
+	if err := d.repo().SetOwnerAddress(ctx, "wallet", "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"); err != nil {
+		t.Fatal(err)
+	}
+	err := d.checkFactors(ctx, "wallet", factors{recoveryCode: "ABCD-EFGH-JKLM"})
+	if !errors.Is(err, errSessionRequired) {
+		t.Fatalf("a recovery code without session got %v, want %v", err, errSessionRequired)
+	}
+}
@@ -0,0 +1,88 @@
+package keydispatcher
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/savour-labs/key-locker/blockchain"
+	"github.com/savour-labs/key-locker/blockchain/ethereum"
+	"github.com/savour-labs/key-locker/blockchain/filecoin"
+	"github.com/savour-labs/key-locker/blockchain/ipfs"
+	"github.com/savour-labs/key-locker/blockchain/moonbeam"
+	"github.com/savour-labs/key-locker/blockchain/plugin"
+	"github.com/savour-labs/key-locker/config"
+)
+
+// AdaptorFactory builds the adaptor of a chain from the config
+type AdaptorFactory func(conf *config.Config) (blockchain.KeyAdaptor, error)
+
+var (
+	factoryMu            sync.RWMutex
+	keyAdaptorFactoryMap = map[ChainType]AdaptorFactory{
+		ethereum.ChainName: ethereum.NewChainAdaptor,
+		moonbeam.ChainName: moonbeam.NewChainAdaptor,
+		ipfs.ChainName:     ipfs.NewChainAdaptor,
+		filecoin.ChainName: filecoin.NewChainAdaptor,
+	}
+	// pluginPaths holds the chains whose factory starts a plugin process
+	pluginPaths = make(map[ChainType]string)
+)
+
+// RegisterAdaptorFactory makes an in-process adaptor available to the chains of the config,
+// it is meant to be called before New, usually from an init function
+func RegisterAdaptorFactory(chain string, factory AdaptorFactory) error {
+	if factory == nil {
+		return errors.New("adaptor factory is nil")
+	}
+	factoryMu.Lock()
+	defer factoryMu.Unlock()
+	if _, ok := keyAdaptorFactoryMap[chain]; ok {
+		return fmt.Errorf("adaptor factory of chain %s is already registered", chain)
+	}
+	keyAdaptorFactoryMap[chain] = factory
+	return nil
+}
+
+func lookupFactory(chain string) (AdaptorFactory, bool) {
+	factoryMu.RLock()
+	defer factoryMu.RUnlock()
+	factory, ok := keyAdaptorFactoryMap[chain]
+	return factory, ok
+}
+
+func supportedChains() []string {
+	factoryMu.RLock()
+	defer factoryMu.RUnlock()
+	chains := make([]string, 0, len(keyAdaptorFactoryMap))
+	for chain := range keyAdaptorFactoryMap {
+		chains = append(chains, chain)
+	}
+	sort.Strings(chains)
+	return chains
+}
+
+// registerPlugins registers a factory for every plugin found in dir,
+// a plugin never replaces an in-process adaptor of the same chain
+func registerPlugins(dir string) {
+	plugins, err := plugin.Discover(dir)
+	if err != nil {
+		log.Error("discover plugins fail", "dir", dir, "err", err)
+		return
+	}
+	factoryMu.Lock()
+	defer factoryMu.Unlock()
+	for chain, path := range plugins {
+		if old, ok := pluginPaths[chain]; ok && old == path {
+			continue
+		} else if _, registered := keyAdaptorFactoryMap[chain]; !ok && registered {
+			log.Warn("plugin ignored, chain already has an adaptor", "chain", chain, "path", path)
+			continue
+		}
+		keyAdaptorFactoryMap[chain] = plugin.NewFactory(chain, path)
+		pluginPaths[chain] = path
+		log.Info("plugin registered", "chain", chain, "path", path)
+	}
+}
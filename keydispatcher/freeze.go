@@ -0,0 +1,272 @@
+package keydispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/savour-labs/key-locker/notify"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultUnfreezeDelay = 24 * time.Hour
+	// maxSignatureTTL bounds the deadline of the owner signatures, limiting their replay
+	maxSignatureTTL = time.Hour
+)
+
+var (
+	errWalletFrozen     = errors.New("wallet is frozen")
+	errInvalidSignature = errors.New("invalid owner signature")
+)
+
+// SetOwnerAddress sets the account whose signatures authenticate the owner of the wallet
+func (d *Dispatcher) SetOwnerAddress(ctx context.Context, req *keylocker.SetOwnerAddressReq) (*keylocker.SetOwnerAddressRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.SetOwnerAddressRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	address := ""
+	if req.Address != "" {
+		if !common.IsHexAddress(req.Address) {
+			return &keylocker.SetOwnerAddressRep{
+				Code: keylocker.ReturnCode_ERROR,
+				Msg:  fmt.Sprintf("invalid address %s", req.Address),
+			}, nil
+		}
+		address = common.HexToAddress(req.Address).Hex()
+	}
+	_, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode)
+	if err == nil {
+		// the owner address is a factor of the unfreeze, leaked credentials must not replace it
+		err = d.checkFrozen(ctx, req.WalletUuid)
+	}
+	if err == nil {
+		err = d.checkOwnerChange(ctx, req)
+	}
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.SetOwnerAddressRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := d.repo().SetOwnerAddress(ctx, req.WalletUuid, address); err != nil {
+		return nil, fmt.Errorf("repo.SetOwnerAddress fail, req, %v, err: [%w]", req, err)
+	}
+	d.notify(&notify.Event{Type: notify.EventOwnerChanged, WalletUuid: req.WalletUuid})
+	return &keylocker.SetOwnerAddressRep{
+		Code: keylocker.ReturnCode_SUCCESS,
+		Msg:  "set owner address success",
+	}, nil
+}
+
+// checkOwnerChange lets the current owner address of the wallet, proven by its session or signature, be replaced,
+// since the session it logs in is a factor of the retrievals. The admin replaces the address of a lost account.
+func (d *Dispatcher) checkOwnerChange(ctx context.Context, req *keylocker.SetOwnerAddressReq) error {
+	sec, err := d.repo().GetByUID(ctx, req.WalletUuid)
+	if err != nil {
+		return fmt.Errorf("repo.GetByUID fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	if sec.OwnerAddress == "" || d.isAdmin(req.ConsumerToken) {
+		return nil
+	}
+	if req.Signature != "" {
+		return d.verifyOwner(ctx, "ChangeOwner", req.WalletUuid, req.Deadline, req.Signature)
+	}
+	return d.checkSession(ctx, sec, req.SessionToken)
+}
+
+// FreezeWallet blocks every retrieval of the keys of the wallet, on the credentials or the owner signature
+func (d *Dispatcher) FreezeWallet(ctx context.Context, req *keylocker.FreezeWalletReq) (*keylocker.FreezeWalletRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.FreezeWalletRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	var err error
+	if req.Signature != "" {
+		err = d.verifyOwner(ctx, "Freeze", req.WalletUuid, req.Deadline, req.Signature)
+	} else {
+		_, err = d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode)
+	}
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.FreezeWalletRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := d.repo().FreezeWallet(ctx, req.WalletUuid, req.Reason); err != nil {
+		return nil, fmt.Errorf("repo.FreezeWallet fail, req, %v, err: [%w]", req, err)
+	}
+	// a change of the guardians waiting for its delay may come from the stolen credentials
+	if _, err := d.repo().CancelGuardianChange(ctx, req.WalletUuid); err != nil {
+		return nil, fmt.Errorf("repo.CancelGuardianChange fail, req, %v, err: [%w]", req, err)
+	}
+	d.notify(&notify.Event{Type: notify.EventWalletFrozen, WalletUuid: req.WalletUuid})
+	return &keylocker.FreezeWalletRep{
+		Code: keylocker.ReturnCode_SUCCESS,
+		Msg:  "freeze wallet success",
+	}, nil
+}
+
+// UnfreezeWallet ends the freeze of the wallet at once when both the credentials and the owner signature
+// are given, otherwise after the unfreeze delay, during which a new freeze cancels it
+func (d *Dispatcher) UnfreezeWallet(ctx context.Context, req *keylocker.UnfreezeWalletReq) (*keylocker.UnfreezeWalletRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.UnfreezeWalletRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	withCredentials := req.Password != "" || req.SocialCode != ""
+	if !withCredentials && req.Signature == "" {
+		return &keylocker.UnfreezeWalletRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "credentials or owner signature is required",
+		}, nil
+	}
+	var err error
+	if withCredentials {
+		_, err = d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode)
+	}
+	if err == nil && req.Signature != "" {
+		err = d.verifyOwner(ctx, "Unfreeze", req.WalletUuid, req.Deadline, req.Signature)
+	}
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.UnfreezeWalletRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	at := now
+	if !withCredentials || req.Signature == "" {
+		delay := time.Duration(d.config().Freeze.UnfreezeDelay) * time.Second
+		if delay <= 0 {
+			delay = defaultUnfreezeDelay
+		}
+		at = now.Add(delay)
+	}
+	repo := d.repo()
+	ok, err := repo.ScheduleUnfreeze(ctx, req.WalletUuid, at)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ScheduleUnfreeze fail, req, %v, err: [%w]", req, err)
+	}
+	if !ok {
+		return &keylocker.UnfreezeWalletRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  "wallet is not frozen",
+		}, nil
+	}
+	freeze, err := repo.GetWalletFreeze(ctx, req.WalletUuid)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetWalletFreeze fail, req, %v, err: [%w]", req, err)
+	}
+	if freeze.Frozen(now) {
+		d.notify(&notify.Event{Type: notify.EventUnfreezeRequested, WalletUuid: req.WalletUuid, ReleaseAt: freeze.UnfreezeAt.Unix()})
+		return &keylocker.UnfreezeWalletRep{
+			Code:       keylocker.ReturnCode_SUCCESS,
+			Msg:        "unfreeze scheduled",
+			UnfreezeAt: freeze.UnfreezeAt.Unix(),
+		}, nil
+	}
+	if err := repo.DeleteEndedFreeze(ctx, req.WalletUuid, now); err != nil {
+		return nil, fmt.Errorf("repo.DeleteEndedFreeze fail, req, %v, err: [%w]", req, err)
+	}
+	d.notify(&notify.Event{Type: notify.EventWalletUnfrozen, WalletUuid: req.WalletUuid})
+	return &keylocker.UnfreezeWalletRep{
+		Code:       keylocker.ReturnCode_SUCCESS,
+		Msg:        "unfreeze wallet success",
+		UnfreezeAt: now.Unix(),
+	}, nil
+}
+
+// checkFrozen returns errWalletFrozen while the wallet is frozen, a freeze which ended is removed
+func (d *Dispatcher) checkFrozen(ctx context.Context, walletUuid string) error {
+	repo := d.repo()
+	freeze, err := repo.GetWalletFreeze(ctx, walletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("repo.GetWalletFreeze fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	now := time.Now()
+	if freeze.Frozen(now) {
+		return errWalletFrozen
+	}
+	if err := repo.DeleteEndedFreeze(ctx, walletUuid, now); err != nil {
+		return fmt.Errorf("repo.DeleteEndedFreeze fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	d.notify(&notify.Event{Type: notify.EventWalletUnfrozen, WalletUuid: walletUuid})
+	return nil
+}
+
+// verifyOwner checks the signature of the owner address of the wallet over the typed data primaryType
+func (d *Dispatcher) verifyOwner(ctx context.Context, primaryType, walletUuid string, deadline int64, signature string) error {
+	now := time.Now()
+	if deadline <= now.Unix() || deadline > now.Add(maxSignatureTTL).Unix() {
+		return fmt.Errorf("%w: deadline must be within %s", errInvalidSignature, maxSignatureTTL)
+	}
+	sec, err := d.repo().GetByUID(ctx, walletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errWalletNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("repo.GetByUID fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	if sec.OwnerAddress == "" {
+		return fmt.Errorf("%w: wallet has no owner address", errInvalidSignature)
+	}
+	digest, err := d.ownerTypedData(primaryType, walletUuid, deadline)
+	if err != nil {
+		return err
+	}
+	signer, err := recoverSigner(digest, signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errInvalidSignature, err)
+	}
+	if signer != common.HexToAddress(sec.OwnerAddress) {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+// ownerTypedData returns the EIP-712 digest the owner signs for primaryType
+func (d *Dispatcher) ownerTypedData(primaryType, walletUuid string, deadline int64) ([]byte, error) {
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": eip712DomainType,
+			primaryType: {
+				{Name: "walletUuid", Type: "string"},
+				{Name: "deadline", Type: "uint256"},
+			},
+		},
+		PrimaryType: primaryType,
+		Domain:      d.eip712Domain(),
+		Message: apitypes.TypedDataMessage{
+			"walletUuid": walletUuid,
+			"deadline":   strconv.FormatInt(deadline, 10),
+		},
+	}
+	digest, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("hash typed data fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	return digest, nil
+}
@@ -0,0 +1,140 @@
+package keydispatcher
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+func TestFrozenWalletKeysNotReleased(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	ctx := context.Background()
+	storeTestKey(t, d, "wallet", "seed phrase")
+	if rep, err := d.StoreShares(ctx, &keylocker.StoreSharesReq{
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+		Shares:     []*keylocker.ShareInput{{Index: 1, Chain: testChain, Share: []byte("share one")}},
+	}); err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("store shares fail: %v %v", rep, err)
+	}
+
+	freeze, err := d.FreezeWallet(ctx, &keylocker.FreezeWalletReq{
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+		Reason:     "stolen phone",
+	})
+	if err != nil || freeze.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("freeze fail: %v %v", freeze, err)
+	}
+
+	get, err := d.GetSocialKey(ctx, &keylocker.GetSocialKeyReq{Chain: testChain, WalletUuid: "wallet"})
+	if err != nil || get.Code != keylocker.ReturnCode_FROZEN {
+		t.Fatalf("get got %v %v, want FROZEN", get, err)
+	}
+	recovered, err := d.RecoverSocialKey(ctx, &keylocker.RecoverSocialKeyReq{
+		Chain:      testChain,
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+	})
+	if err != nil || recovered.Code != keylocker.ReturnCode_FROZEN {
+		t.Fatalf("recover got %v %v, want FROZEN", recovered, err)
+	}
+	transfer, err := d.TransferSocialKey(ctx, &keylocker.TransferSocialKeyReq{
+		Chain:         testChain,
+		WalletUuid:    "wallet",
+		Password:      sealed(t, testPassword),
+		SocialCode:    sealed(t, testSocialCode),
+		NewWalletUuid: "thief",
+		NewPassword:   sealed(t, "Nw5!tRq9yK"),
+		NewSocialCode: sealed(t, "731046"),
+	})
+	if err != nil || transfer.Code != keylocker.ReturnCode_FROZEN {
+		t.Fatalf("transfer got %v %v, want FROZEN", transfer, err)
+	}
+	if n, err := d.repo().CountKeysByUID(ctx, "thief"); err != nil || n != 0 {
+		t.Fatalf("%d keys transferred while frozen, err %v", n, err)
+	}
+	share, err := d.GetShare(ctx, &keylocker.GetShareReq{
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+		Index:      1,
+	})
+	if err != nil || share.Code != keylocker.ReturnCode_FROZEN {
+		t.Fatalf("get share got %v %v, want FROZEN", share, err)
+	}
+}
+
+func TestOwnerAddressChangeNeedsCurrentOwner(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	ctx := context.Background()
+	storeTestKey(t, d, "wallet", "seed phrase")
+	owner, _ := ethcrypto.GenerateKey()
+	thief, _ := ethcrypto.GenerateKey()
+	setOwner := func(req *keylocker.SetOwnerAddressReq) *keylocker.SetOwnerAddressRep {
+		t.Helper()
+		req.WalletUuid = "wallet"
+		req.Password = sealed(t, testPassword)
+		req.SocialCode = sealed(t, testSocialCode)
+		rep, err := d.SetOwnerAddress(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rep
+	}
+	ownerAddress := func() string {
+		t.Helper()
+		sec, err := d.repo().GetByUID(ctx, "wallet")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sec.OwnerAddress
+	}
+
+	if rep := setOwner(&keylocker.SetOwnerAddressReq{Address: ethcrypto.PubkeyToAddress(owner.PublicKey).Hex()}); rep.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("set the first owner address fail: %v", rep)
+	}
+	// the credentials alone do not replace the owner
+	if rep := setOwner(&keylocker.SetOwnerAddressReq{Address: ethcrypto.PubkeyToAddress(thief.PublicKey).Hex()}); rep.Code != keylocker.ReturnCode_SESSION_REQUIRED {
+		t.Fatalf("replace the owner with the credentials got %v, want SESSION_REQUIRED", rep)
+	}
+	if rep := setOwner(&keylocker.SetOwnerAddressReq{}); rep.Code != keylocker.ReturnCode_SESSION_REQUIRED {
+		t.Fatalf("remove the owner with the credentials got %v, want SESSION_REQUIRED", rep)
+	}
+	if got := ownerAddress(); got != ethcrypto.PubkeyToAddress(owner.PublicKey).Hex() {
+		t.Fatalf("owner address is %s, want the first one", got)
+	}
+
+	sign := func(key *ecdsa.PrivateKey, deadline int64) string {
+		t.Helper()
+		digest, err := d.ownerTypedData("ChangeOwner", "wallet", deadline)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig, err := ethcrypto.Sign(digest, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig[64] += 27
+		return hexutil.Encode(sig)
+	}
+	deadline := time.Now().Add(time.Minute).Unix()
+	newAddress := ethcrypto.PubkeyToAddress(thief.PublicKey).Hex()
+	if rep := setOwner(&keylocker.SetOwnerAddressReq{Address: newAddress, Deadline: deadline, Signature: sign(thief, deadline)}); rep.Code != keylocker.ReturnCode_INVALID_CREDENTIALS {
+		t.Fatalf("replace the owner with the signature of the new one got %v, want INVALID_CREDENTIALS", rep)
+	}
+	if rep := setOwner(&keylocker.SetOwnerAddressReq{Address: newAddress, Deadline: deadline, Signature: sign(owner, deadline)}); rep.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("replace the owner with its signature fail: %v", rep)
+	}
+	if got := ownerAddress(); got != newAddress {
+		t.Fatalf("owner address is %s, want %s", got, newAddress)
+	}
+}
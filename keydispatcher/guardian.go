@@ -0,0 +1,148 @@
+package keydispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/savour-labs/key-locker/notify"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"gorm.io/gorm"
+)
+
+const defaultGuardianChangeDelay = 3 * 24 * time.Hour
+
+// SetGuardians replaces the guardians of the wallet and the number of them that have to approve a recovery,
+// the wallet credentials are required so that nobody else can swap the guardians. The first guardians apply
+// at once, a change of them waits for the guardian change delay, so that stolen credentials can not set
+// guardians of their own and approve their own recovery before the owner freezes the wallet.
+func (d *Dispatcher) SetGuardians(ctx context.Context, req *keylocker.SetGuardiansReq) (*keylocker.SetGuardiansRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.SetGuardiansRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	guardians, err := normalizeGuardians(req.Guardians)
+	if err != nil {
+		return &keylocker.SetGuardiansRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, nil
+	}
+	if len(guardians) > 0 && (req.Threshold == 0 || int(req.Threshold) > len(guardians)) {
+		return &keylocker.SetGuardiansRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  fmt.Sprintf("threshold must be between 1 and %d", len(guardians)),
+		}, nil
+	}
+	_, err = d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode)
+	if err == nil {
+		err = d.checkFrozen(ctx, req.WalletUuid)
+	}
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.SetGuardiansRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	repo := d.repo()
+	_, _, err = repo.GetRecoveryPolicy(ctx, req.WalletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		if err := repo.SetGuardians(ctx, req.WalletUuid, guardians, req.Threshold); err != nil {
+			return nil, fmt.Errorf("repo.SetGuardians fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+		}
+		d.notify(&notify.Event{Type: notify.EventGuardiansChanged, WalletUuid: req.WalletUuid})
+		return &keylocker.SetGuardiansRep{
+			Code: keylocker.ReturnCode_SUCCESS,
+			Msg:  "set guardians success",
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetRecoveryPolicy fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	delay, err := d.guardianChangeDelay(ctx, req.WalletUuid)
+	if err != nil {
+		return nil, err
+	}
+	applyAt := time.Now().Add(delay)
+	if err := repo.SetGuardianChange(ctx, req.WalletUuid, guardians, req.Threshold, applyAt); err != nil {
+		return nil, fmt.Errorf("repo.SetGuardianChange fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	d.notify(&notify.Event{Type: notify.EventGuardiansChangeRequested, WalletUuid: req.WalletUuid})
+	return &keylocker.SetGuardiansRep{
+		Code:      keylocker.ReturnCode_SUCCESS,
+		Msg:       "guardians change scheduled",
+		PendingAt: applyAt.Unix(),
+	}, nil
+}
+
+// guardianChangeDelay is the delay of the config, or the recovery delay of the wallet when it is longer
+func (d *Dispatcher) guardianChangeDelay(ctx context.Context, walletUuid string) (time.Duration, error) {
+	delay := time.Duration(d.config().Recovery.GuardianChangeDelay) * time.Second
+	if delay <= 0 {
+		delay = defaultGuardianChangeDelay
+	}
+	recoveryDelay, err := d.recoveryDelay(ctx, walletUuid)
+	if err != nil {
+		return 0, err
+	}
+	if wallet := time.Duration(recoveryDelay) * time.Second; wallet > delay {
+		delay = wallet
+	}
+	return delay, nil
+}
+
+func (d *Dispatcher) GetGuardians(ctx context.Context, req *keylocker.GetGuardiansReq) (*keylocker.GetGuardiansRep, error) {
+	policy, guardians, err := d.repo().GetRecoveryPolicy(ctx, req.WalletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &keylocker.GetGuardiansRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  "wallet has no guardians",
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetRecoveryPolicy fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	rep := &keylocker.GetGuardiansRep{
+		Code:      keylocker.ReturnCode_SUCCESS,
+		Msg:       "get guardians success",
+		Guardians: guardians,
+		Threshold: policy.Threshold,
+	}
+	change, err := d.repo().GetGuardianChange(ctx, req.WalletUuid)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("repo.GetGuardianChange fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	if change != nil {
+		rep.PendingGuardians = change.Addresses()
+		rep.PendingThreshold = change.Threshold
+		rep.PendingAt = change.ApplyAt.Unix()
+	}
+	return rep, nil
+}
+
+// normalizeGuardians checksums the guardian addresses and rejects duplicates
+func normalizeGuardians(addresses []string) ([]string, error) {
+	seen := make(map[common.Address]bool, len(addresses))
+	res := make([]string, 0, len(addresses))
+	for _, a := range addresses {
+		if !common.IsHexAddress(a) {
+			return nil, fmt.Errorf("invalid guardian address %q", a)
+		}
+		addr := common.HexToAddress(a)
+		if addr == (common.Address{}) {
+			return nil, fmt.Errorf("invalid guardian address %q", a)
+		}
+		if seen[addr] {
+			return nil, fmt.Errorf("duplicate guardian %s", addr.Hex())
+		}
+		seen[addr] = true
+		res = append(res, addr.Hex())
+	}
+	return res, nil
+}
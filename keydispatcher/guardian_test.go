@@ -0,0 +1,87 @@
+package keydispatcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+const (
+	ownerGuardian = "0x00000000000000000000000000000000000000A1"
+	thiefGuardian = "0x00000000000000000000000000000000000000B2"
+)
+
+func TestGuardianChangeWaitsForDelay(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	ctx := context.Background()
+	storeTestKey(t, d, "wallet", "seed phrase")
+	setGuardians := func(guardian string) *keylocker.SetGuardiansRep {
+		t.Helper()
+		rep, err := d.SetGuardians(ctx, &keylocker.SetGuardiansReq{
+			WalletUuid: "wallet",
+			Password:   sealed(t, testPassword),
+			SocialCode: sealed(t, testSocialCode),
+			Guardians:  []string{guardian},
+			Threshold:  1,
+		})
+		if err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+			t.Fatalf("set guardians fail: %v %v", rep, err)
+		}
+		return rep
+	}
+	guardians := func() []string {
+		t.Helper()
+		_, addresses, err := d.repo().GetRecoveryPolicy(ctx, "wallet")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return addresses
+	}
+
+	// the first guardians apply at once
+	if rep := setGuardians(ownerGuardian); rep.PendingAt != 0 {
+		t.Fatalf("the first guardians wait until %d", rep.PendingAt)
+	}
+	// the credentials alone only schedule a change
+	rep := setGuardians(thiefGuardian)
+	if rep.PendingAt < time.Now().Add(defaultGuardianChangeDelay-time.Minute).Unix() {
+		t.Fatalf("the change applies at %d, before the delay", rep.PendingAt)
+	}
+	if got := guardians(); len(got) != 1 || got[0] != common.HexToAddress(ownerGuardian).Hex() {
+		t.Fatalf("guardians are %v right after the change, want the owner one", got)
+	}
+
+	// the change applies once due
+	if err := d.repo().DB.Model(&model.GuardianChange{}).Where("key_uuid = ?", "wallet").Update("apply_at", time.Now().Add(-time.Second)).Error; err != nil {
+		t.Fatal(err)
+	}
+	if got := guardians(); len(got) != 1 || got[0] != common.HexToAddress(thiefGuardian).Hex() {
+		t.Fatalf("guardians are %v after the delay, want the new one", got)
+	}
+
+	// a freeze cancels a change waiting for its delay
+	setGuardians(ownerGuardian)
+	freeze, err := d.FreezeWallet(ctx, &keylocker.FreezeWalletReq{
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+	})
+	if err != nil || freeze.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("freeze fail: %v %v", freeze, err)
+	}
+	if _, err := d.repo().GetGuardianChange(ctx, "wallet"); err == nil {
+		t.Fatal("the change survives the freeze")
+	}
+	frozen, err := d.SetGuardians(ctx, &keylocker.SetGuardiansReq{
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+	})
+	if err != nil || frozen.Code != keylocker.ReturnCode_FROZEN {
+		t.Fatalf("set guardians of a frozen wallet got %v %v, want FROZEN", frozen, err)
+	}
+}
@@ -0,0 +1,174 @@
+package keydispatcher
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gorm.io/gorm"
+)
+
+const (
+	idempotencyPollInterval  = 200 * time.Millisecond
+	defaultIdempotencyExpiry = 600 * time.Second
+)
+
+// setSocialKeyOnce runs SetSocialKey at most once for a consumer and idempotency key. The pending
+// record claims the key, a replay gets the stored response, and a duplicate sent while the first
+// request runs waits for it up to rpcserver.idempotency_wait before it is rejected. A key still pending
+// after rpcserver.idempotency_expiry was left by a request which never finished and a retry takes it over.
+func (d *Dispatcher) setSocialKeyOnce(ctx context.Context, req *keylocker.SetSocialKeyReq) (*keylocker.SetSocialKeyRep, error) {
+	hash, err := requestHash(req)
+	if err != nil {
+		return nil, fmt.Errorf("hash request fail, err: [%w]", err)
+	}
+	repo := d.repo()
+	rec := &model.IdempotencyRecord{
+		ConsumerToken:  req.ConsumerToken,
+		IdempotencyKey: req.IdempotencyKey,
+		RequestHash:    hash,
+	}
+	created, err := repo.CreateIdempotencyRecord(ctx, rec)
+	if err != nil {
+		return nil, fmt.Errorf("repo.CreateIdempotencyRecord fail, key, %s, err: [%w]", req.IdempotencyKey, err)
+	}
+	if !created {
+		return d.replaySetSocialKey(ctx, req, hash)
+	}
+	return d.runSetSocialKeyOnce(ctx, req, rec)
+}
+
+// runSetSocialKeyOnce runs the request holding the pending record and stores its response for the replays
+func (d *Dispatcher) runSetSocialKeyOnce(ctx context.Context, req *keylocker.SetSocialKeyReq, rec *model.IdempotencyRecord) (*keylocker.SetSocialKeyRep, error) {
+	repo := d.repo()
+	rep, err := d.setSocialKey(ctx, req)
+	if err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+		// nothing to replay, free the key for a retry
+		if e := repo.DeleteIdempotencyRecord(context.Background(), rec); e != nil {
+			log.Error("release idempotency key fail", "key", req.IdempotencyKey, "err", e)
+		}
+		return rep, err
+	}
+	response, err := proto.Marshal(binarySafe(rep))
+	if err == nil {
+		err = repo.FinishIdempotencyRecord(context.Background(), rec, response)
+	}
+	if err != nil {
+		log.Error("save idempotent response fail", "key", req.IdempotencyKey, "err", err)
+	}
+	return rep, nil
+}
+
+func (d *Dispatcher) replaySetSocialKey(ctx context.Context, req *keylocker.SetSocialKeyReq, hash string) (*keylocker.SetSocialKeyRep, error) {
+	deadline := time.Now().Add(time.Duration(d.config().RpcServer.IdempotencyWait) * time.Second)
+	for {
+		rec, err := d.repo().GetIdempotencyRecord(ctx, req.ConsumerToken, req.IdempotencyKey)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// the first request failed and released the key
+			return d.setSocialKeyOnce(ctx, req)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("repo.GetIdempotencyRecord fail, key, %s, err: [%w]", req.IdempotencyKey, err)
+		}
+		if rec.RequestHash != hash {
+			return &keylocker.SetSocialKeyRep{
+				Code: keylocker.ReturnCode_ERROR,
+				Msg:  "idempotency key was used with a different request",
+			}, nil
+		}
+		if rec.Status == model.IdempotencyDone {
+			stored := new(keylocker.SetSocialKeyRep)
+			if err := proto.Unmarshal(rec.Response, stored); err != nil {
+				return nil, fmt.Errorf("unmarshal stored response fail, key, %s, err: [%w]", req.IdempotencyKey, err)
+			}
+			rep, err := fromBinarySafe(stored)
+			if err != nil {
+				return nil, fmt.Errorf("decode stored response fail, key, %s, err: [%w]", req.IdempotencyKey, err)
+			}
+			return rep.(*keylocker.SetSocialKeyRep), nil
+		}
+		if now := time.Now(); now.Sub(rec.UpdatedAt) > d.idempotencyExpiry() {
+			ok, err := d.repo().TakeOverIdempotencyRecord(ctx, rec, now)
+			if err != nil {
+				return nil, fmt.Errorf("repo.TakeOverIdempotencyRecord fail, key, %s, err: [%w]", req.IdempotencyKey, err)
+			}
+			if ok {
+				log.Warn("take over expired idempotency key", "key", req.IdempotencyKey, "pendingSince", rec.UpdatedAt)
+				return d.runSetSocialKeyOnce(ctx, req, rec)
+			}
+			continue
+		}
+		if time.Now().After(deadline) {
+			return &keylocker.SetSocialKeyRep{
+				Code: keylocker.ReturnCode_ERROR,
+				Msg:  "a request with the same idempotency key is in progress",
+			}, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(idempotencyPollInterval):
+		}
+	}
+}
+
+func (d *Dispatcher) idempotencyExpiry() time.Duration {
+	if expiry := time.Duration(d.config().RpcServer.IdempotencyExpiry) * time.Second; expiry > 0 {
+		return expiry
+	}
+	return defaultIdempotencyExpiry
+}
+
+// requestHash identifies the request payload, so a key reused for another request is detected
+func requestHash(req *keylocker.SetSocialKeyReq) (string, error) {
+	payload := binarySafe(req).(*keylocker.SetSocialKeyReq)
+	payload.IdempotencyKey = ""
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// binarySafe returns a copy of the message with its string fields base64 encoded, the credentials and the
+// encrypted rsa private key are binary which proto strings can not be marshalled with
+func binarySafe(m proto.Message) proto.Message {
+	c := proto.Clone(m)
+	r := c.ProtoReflect()
+	r.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() == protoreflect.StringKind && fd.Cardinality() != protoreflect.Repeated {
+			r.Set(fd, protoreflect.ValueOfString(base64.StdEncoding.EncodeToString([]byte(v.String()))))
+		}
+		return true
+	})
+	return c
+}
+
+// fromBinarySafe decodes the string fields of a message encoded by binarySafe
+func fromBinarySafe(m proto.Message) (proto.Message, error) {
+	c := proto.Clone(m)
+	r := c.ProtoReflect()
+	var err error
+	r.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.StringKind || fd.Cardinality() == protoreflect.Repeated {
+			return true
+		}
+		var raw []byte
+		if raw, err = base64.StdEncoding.DecodeString(v.String()); err != nil {
+			return false
+		}
+		r.Set(fd, protoreflect.ValueOfString(string(raw)))
+		return true
+	})
+	return c, err
+}
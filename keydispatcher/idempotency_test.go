@@ -0,0 +1,84 @@
+package keydispatcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSetSocialKeyReplay(t *testing.T) {
+	d, adaptor := newTestDispatcher(t)
+	ctx := context.Background()
+	req := &keylocker.SetSocialKeyReq{
+		ConsumerToken:  "consumer",
+		Chain:          testChain,
+		WalletUuid:     "wallet",
+		Key:            "seed phrase",
+		Password:       sealed(t, testPassword),
+		SocialCode:     sealed(t, testSocialCode),
+		IdempotencyKey: "import-1",
+	}
+	first, err := d.SetSocialKey(ctx, req)
+	if err != nil || first.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("set social key fail: %v %v", first, err)
+	}
+	replay, err := d.SetSocialKey(ctx, req)
+	if err != nil || replay.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("replay fail: %v %v", replay, err)
+	}
+	if replay.KeyId != first.KeyId || replay.Version != first.Version || replay.Priv != first.Priv {
+		t.Fatalf("replay got %v, want %v", replay, first)
+	}
+	if n := adaptor.setCalls(); n != 1 {
+		t.Fatalf("the backend stored %d keys, want 1", n)
+	}
+
+	other := proto.Clone(req).(*keylocker.SetSocialKeyReq)
+	other.Key = "another seed phrase"
+	rep, err := d.SetSocialKey(ctx, other)
+	if err != nil || rep.Code != keylocker.ReturnCode_ERROR {
+		t.Fatalf("a reused key must be rejected, got %v %v", rep, err)
+	}
+}
+
+func TestSetSocialKeyTakesOverExpiredKey(t *testing.T) {
+	d, adaptor := newTestDispatcher(t)
+	d.conf.RpcServer.IdempotencyExpiry = 60
+	ctx := context.Background()
+	req := &keylocker.SetSocialKeyReq{
+		ConsumerToken:  "consumer",
+		Chain:          testChain,
+		WalletUuid:     "wallet",
+		Key:            "seed phrase",
+		Password:       sealed(t, testPassword),
+		SocialCode:     sealed(t, testSocialCode),
+		IdempotencyKey: "import-1",
+	}
+	hash, err := requestHash(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a request which crashed before finishing left its key pending
+	rec := &model.IdempotencyRecord{ConsumerToken: req.ConsumerToken, IdempotencyKey: req.IdempotencyKey, RequestHash: hash}
+	if _, err := d.repo().CreateIdempotencyRecord(ctx, rec); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.repo().DB.Model(rec).UpdateColumn("updated_at", time.Now().Add(-time.Hour)).Error; err != nil {
+		t.Fatal(err)
+	}
+	rep, err := d.SetSocialKey(ctx, req)
+	if err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("an expired key must be taken over, got %v %v", rep, err)
+	}
+	if n := adaptor.setCalls(); n != 1 {
+		t.Fatalf("the backend stored %d keys, want 1", n)
+	}
+	stored, err := d.repo().GetIdempotencyRecord(ctx, req.ConsumerToken, req.IdempotencyKey)
+	if err != nil || stored.Status != model.IdempotencyDone {
+		t.Fatalf("the taken over key must be finished, got %v %v", stored, err)
+	}
+}
@@ -0,0 +1,394 @@
+package keydispatcher
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/savour-labs/key-locker/crypto"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/notify"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultInheritanceInterval  = 60
+	defaultInheritanceMinPeriod = 30 * 24 * 3600
+)
+
+// SetInheritance sets the beneficiary the keys of the wallet are released to once the owner stops sending
+// heartbeats. The latest version of every key is re-encrypted to the beneficiary now, with the credentials,
+// and held back until the release. The keys stored later are held back as they are stored.
+func (d *Dispatcher) SetInheritance(ctx context.Context, req *keylocker.SetInheritanceReq) (*keylocker.SetInheritanceRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.SetInheritanceRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	var beneficiary *crypto.Rsa
+	if req.BeneficiaryPub != "" {
+		var err error
+		if beneficiary, err = crypto.LoadRsa(req.BeneficiaryPub, ""); err != nil {
+			return &keylocker.SetInheritanceRep{
+				Code: keylocker.ReturnCode_ERROR,
+				Msg:  fmt.Sprintf("invalid beneficiary_pub: %v", err),
+			}, nil
+		}
+		// a short period would let stolen credentials release the keys to their own beneficiary soon
+		minPeriod := d.config().Inheritance.MinPeriod
+		if minPeriod == 0 {
+			minPeriod = defaultInheritanceMinPeriod
+		}
+		if req.Period < minPeriod {
+			return &keylocker.SetInheritanceRep{
+				Code: keylocker.ReturnCode_ERROR,
+				Msg:  fmt.Sprintf("period must be at least %d seconds", minPeriod),
+			}, nil
+		}
+		if req.WarnBefore >= req.Period {
+			return &keylocker.SetInheritanceRep{
+				Code: keylocker.ReturnCode_ERROR,
+				Msg:  "warn_before must be shorter than period",
+			}, nil
+		}
+	}
+	rsaObj, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode)
+	if err == nil {
+		err = d.checkFrozen(ctx, req.WalletUuid)
+	}
+	if err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.SetInheritanceRep{
+				Code: code,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	repo := d.repo()
+	if req.BeneficiaryPub == "" {
+		if err := repo.DeleteInheritancePolicy(ctx, req.WalletUuid); err != nil {
+			return nil, fmt.Errorf("repo.DeleteInheritancePolicy fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+		}
+		d.notify(&notify.Event{Type: notify.EventInheritanceChanged, WalletUuid: req.WalletUuid})
+		return &keylocker.SetInheritanceRep{
+			Code: keylocker.ReturnCode_SUCCESS,
+			Msg:  "inheritance removed",
+		}, nil
+	}
+	inherited, err := d.reencryptKeys(ctx, req.WalletUuid, rsaObj, beneficiary)
+	if err != nil {
+		return nil, fmt.Errorf("re-encrypt keys to the beneficiary fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	conf := d.config()
+	warnBefore := req.WarnBefore
+	if warnBefore == 0 {
+		warnBefore = conf.Inheritance.WarnBefore
+		if warnBefore >= req.Period {
+			warnBefore = req.Period / 2
+		}
+	}
+	policy := &model.InheritancePolicy{
+		KeyUuid:        req.WalletUuid,
+		BeneficiaryPub: req.BeneficiaryPub,
+		Period:         req.Period,
+		WarnBefore:     warnBefore,
+	}
+	policy.Beat(time.Now())
+	if err := repo.SaveInheritancePolicy(ctx, policy, inherited); err != nil {
+		return nil, fmt.Errorf("repo.SaveInheritancePolicy fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	d.notify(&notify.Event{Type: notify.EventInheritanceChanged, WalletUuid: req.WalletUuid, ReleaseAt: policy.Deadline.Unix()})
+	return &keylocker.SetInheritanceRep{
+		Code:        keylocker.ReturnCode_SUCCESS,
+		Msg:         "set inheritance success",
+		Inheritance: inheritanceInfo(policy),
+	}, nil
+}
+
+// Heartbeat tells that the owner is still around, it moves the deadline of the inheritance policy
+func (d *Dispatcher) Heartbeat(ctx context.Context, req *keylocker.HeartbeatReq) (*keylocker.HeartbeatRep, error) {
+	_, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode)
+	if err == nil {
+		err = d.checkFrozen(ctx, req.WalletUuid)
+	}
+	if err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.HeartbeatRep{
+				Code: code,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	repo := d.repo()
+	policy, err := repo.GetInheritancePolicy(ctx, req.WalletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &keylocker.HeartbeatRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  "wallet has no inheritance",
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetInheritancePolicy fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	ok, err := repo.Heartbeat(ctx, policy, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("repo.Heartbeat fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	if !ok {
+		return &keylocker.HeartbeatRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "inheritance already released",
+		}, nil
+	}
+	return &keylocker.HeartbeatRep{
+		Code:        keylocker.ReturnCode_SUCCESS,
+		Msg:         "heartbeat success",
+		Inheritance: inheritanceInfo(policy),
+	}, nil
+}
+
+func (d *Dispatcher) GetInheritance(ctx context.Context, req *keylocker.GetInheritanceReq) (*keylocker.GetInheritanceRep, error) {
+	policy, err := d.repo().GetInheritancePolicy(ctx, req.WalletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &keylocker.GetInheritanceRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  "wallet has no inheritance",
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetInheritancePolicy fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	return &keylocker.GetInheritanceRep{
+		Code:        keylocker.ReturnCode_SUCCESS,
+		Msg:         "get inheritance success",
+		Inheritance: inheritanceInfo(policy),
+	}, nil
+}
+
+// GetInheritedKeys returns the keys released to the beneficiary, encrypted with its public key
+func (d *Dispatcher) GetInheritedKeys(ctx context.Context, req *keylocker.GetInheritedKeysReq) (*keylocker.GetInheritedKeysRep, error) {
+	repo := d.repo()
+	policy, err := repo.GetInheritancePolicy(ctx, req.WalletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &keylocker.GetInheritedKeysRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  "wallet has no inheritance",
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetInheritancePolicy fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	if policy.Status != model.InheritanceStatusReleased {
+		return &keylocker.GetInheritedKeysRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "inheritance is not released",
+		}, nil
+	}
+	keys, err := repo.ListInheritedKeys(ctx, req.WalletUuid)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListInheritedKeys fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	keyList := make([]*keylocker.InheritedKey, 0, len(keys))
+	for _, k := range keys {
+		keyList = append(keyList, &keylocker.InheritedKey{
+			Chain:   k.Chain,
+			Id:      k.KeyId,
+			Label:   k.Label,
+			Version: k.Version,
+			Key:     k.Key,
+		})
+	}
+	return &keylocker.GetInheritedKeysRep{
+		Code:    keylocker.ReturnCode_SUCCESS,
+		Msg:     "get inherited keys success",
+		KeyList: keyList,
+	}, nil
+}
+
+// RunInheritance warns the owners whose deadline comes near and releases the keys of the policies whose
+// deadline passed, every inheritance.interval seconds until ctx is done
+func (d *Dispatcher) RunInheritance(ctx context.Context) {
+	for {
+		d.checkInheritance(ctx, time.Now())
+		interval := d.config().Inheritance.Interval
+		if interval <= 0 {
+			interval = defaultInheritanceInterval
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(interval) * time.Second):
+		}
+	}
+}
+
+func (d *Dispatcher) checkInheritance(ctx context.Context, now time.Time) {
+	defer d.begin()()
+	repo := d.repo()
+	warn, err := repo.ListInheritanceToWarn(ctx, now)
+	if err != nil {
+		log.Error("list inheritance to warn fail", "err", err)
+	}
+	for _, p := range warn {
+		ok, err := repo.MarkInheritanceWarned(ctx, p.KeyUuid, p.WarnAt)
+		if err != nil {
+			log.Error("mark inheritance warned fail", "walletUuid", p.KeyUuid, "err", err)
+			continue
+		}
+		if ok {
+			d.notify(&notify.Event{Type: notify.EventInheritanceWarning, WalletUuid: p.KeyUuid, ReleaseAt: p.Deadline.Unix()})
+		}
+	}
+	due, err := repo.ListInheritanceDue(ctx, now)
+	if err != nil {
+		log.Error("list inheritance due fail", "err", err)
+	}
+	for _, p := range due {
+		if err := d.releaseInheritance(ctx, p); err != nil {
+			log.Error("release inheritance fail, retry on next check", "walletUuid", p.KeyUuid, "err", err)
+		}
+	}
+}
+
+// releaseInheritance gives the beneficiary the keys held back for it, the release of a frozen wallet waits
+// for the end of the freeze
+func (d *Dispatcher) releaseInheritance(ctx context.Context, policy *model.InheritancePolicy) error {
+	if err := d.checkFrozen(ctx, policy.KeyUuid); err != nil {
+		return err
+	}
+	repo := d.repo()
+	ok, err := repo.ReleaseInheritance(ctx, policy)
+	if err != nil {
+		return fmt.Errorf("repo.ReleaseInheritance fail, err: [%w]", err)
+	}
+	if !ok {
+		// a heartbeat came in meanwhile
+		return nil
+	}
+	keys, err := repo.ListInheritedKeys(ctx, policy.KeyUuid)
+	if err != nil {
+		log.Error("list inherited keys fail", "walletUuid", policy.KeyUuid, "err", err)
+	}
+	if err := d.audit(ctx, &model.AuditRecord{
+		Action:  model.AuditActionInheritance,
+		KeyUuid: policy.KeyUuid,
+		Reason:  fmt.Sprintf("no heartbeat since %s", policy.LastHeartbeat.UTC().Format(time.RFC3339)),
+	}, map[string]interface{}{
+		"keys": len(keys),
+	}); err != nil {
+		log.Error("audit inheritance release fail", "walletUuid", policy.KeyUuid, "err", err)
+	}
+	d.notify(&notify.Event{Type: notify.EventInheritanceReleased, WalletUuid: policy.KeyUuid, ReleaseAt: policy.Deadline.Unix()})
+	return nil
+}
+
+// inheritKey holds the key just stored as keyId and version for the beneficiary of the wallet,
+// when the wallet has an inheritance policy not released yet
+func (d *Dispatcher) inheritKey(ctx context.Context, walletUuid, chain, keyId string, version uint64, plain []byte) error {
+	repo := d.repo()
+	policy, err := repo.GetInheritancePolicy(ctx, walletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("repo.GetInheritancePolicy fail, err: [%w]", err)
+	}
+	if policy.Status == model.InheritanceStatusReleased {
+		return nil
+	}
+	if share, err := d.isShareKey(ctx, walletUuid, keyId); err != nil || share {
+		return err
+	}
+	key, err := repo.GetKeyVersion(ctx, walletUuid, chain, keyId, version)
+	if err != nil {
+		return fmt.Errorf("repo.GetKeyVersion fail, err: [%w]", err)
+	}
+	beneficiary, err := crypto.LoadRsa(policy.BeneficiaryPub, "")
+	if err != nil {
+		return fmt.Errorf("load beneficiary key fail, err: [%w]", err)
+	}
+	enc, err := beneficiary.Encrypt(plain)
+	if err != nil {
+		return fmt.Errorf("encrypt key fail, err: [%w]", err)
+	}
+	if _, err := repo.SaveInheritedKey(ctx, &model.InheritedKey{
+		KeyUuid: walletUuid,
+		Chain:   chain,
+		KeyId:   keyId,
+		Label:   key.Label,
+		Version: version,
+		Key:     base64.StdEncoding.EncodeToString(enc),
+	}); err != nil {
+		return fmt.Errorf("repo.SaveInheritedKey fail, err: [%w]", err)
+	}
+	return nil
+}
+
+func (d *Dispatcher) reencryptKeys(ctx context.Context, walletUuid string, owner, beneficiary *crypto.Rsa) ([]*model.InheritedKey, error) {
+	keys, err := d.repo().ListKeysByUID(ctx, walletUuid, "")
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListKeysByUID fail, err: [%w]", err)
+	}
+	var chains []string
+	seen := make(map[string]bool)
+	for _, k := range keys {
+		if !seen[k.Chain] {
+			seen[k.Chain] = true
+			chains = append(chains, k.Chain)
+		}
+	}
+	var res []*model.InheritedKey
+	for _, chain := range chains {
+		adaptor, ok := d.acquire(chain)
+		if !ok {
+			return nil, fmt.Errorf("chain %s is not served", chain)
+		}
+		rep, err := d.getSocialKey(ctx, adaptor, &keylocker.GetSocialKeyReq{Chain: chain, WalletUuid: walletUuid})
+		adaptor.release()
+		if err != nil {
+			return nil, fmt.Errorf("GetSocialKey fail, chain, %s, err: [%w]", chain, err)
+		}
+		if rep.Code != keylocker.ReturnCode_SUCCESS {
+			return nil, fmt.Errorf("GetSocialKey fail, chain, %s, msg: %s", chain, rep.Msg)
+		}
+		for _, k := range rep.KeyList {
+			plain, err := owner.Decrypt([]byte(k.Key))
+			if err != nil {
+				return nil, fmt.Errorf("decrypt key %s version %d fail, err: [%w]", k.Id, k.Version, err)
+			}
+			enc, err := beneficiary.Encrypt(plain)
+			if err != nil {
+				return nil, fmt.Errorf("encrypt key %s version %d fail, err: [%w]", k.Id, k.Version, err)
+			}
+			res = append(res, &model.InheritedKey{
+				KeyUuid: walletUuid,
+				Chain:   chain,
+				KeyId:   k.Id,
+				Label:   k.Label,
+				Version: k.Version,
+				Key:     base64.StdEncoding.EncodeToString(enc),
+			})
+		}
+	}
+	return res, nil
+}
+
+func inheritanceInfo(policy *model.InheritancePolicy) *keylocker.Inheritance {
+	return &keylocker.Inheritance{
+		WalletUuid:     policy.KeyUuid,
+		BeneficiaryPub: policy.BeneficiaryPub,
+		Period:         policy.Period,
+		WarnBefore:     policy.WarnBefore,
+		Status:         policy.Status,
+		LastHeartbeat:  policy.LastHeartbeat.Unix(),
+		Deadline:       policy.Deadline.Unix(),
+	}
+}
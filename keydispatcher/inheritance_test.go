@@ -0,0 +1,101 @@
+package keydispatcher
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/savour-labs/key-locker/crypto"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+func TestInheritanceReleasesHeldKeys(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	ctx := context.Background()
+	storeTestKey(t, d, "wallet", "first seed phrase")
+	priv, pub := crypto.NewRsa("", "").CreatePkcs8Keys(1024)
+	rep, err := d.SetInheritance(ctx, &keylocker.SetInheritanceReq{
+		WalletUuid:     "wallet",
+		Password:       sealed(t, testPassword),
+		SocialCode:     sealed(t, testSocialCode),
+		BeneficiaryPub: pub,
+		Period:         defaultInheritanceMinPeriod,
+	})
+	if err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("set inheritance fail: %v %v", rep, err)
+	}
+	// a key stored after the policy is held for the beneficiary too
+	storeTestKey(t, d, "wallet", "second seed phrase")
+
+	held, err := d.GetInheritedKeys(ctx, &keylocker.GetInheritedKeysReq{WalletUuid: "wallet"})
+	if err != nil || held.Code != keylocker.ReturnCode_ERROR || len(held.KeyList) != 0 {
+		t.Fatalf("the keys must be held back until the release, got %v %v", held, err)
+	}
+
+	d.checkInheritance(ctx, time.Now().Add(2*defaultInheritanceMinPeriod*time.Second))
+	released, err := d.GetInheritedKeys(ctx, &keylocker.GetInheritedKeysReq{WalletUuid: "wallet"})
+	if err != nil || released.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("get inherited keys fail: %v %v", released, err)
+	}
+	beneficiary, err := crypto.LoadRsa(pub, priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, k := range released.KeyList {
+		enc, err := base64.StdEncoding.DecodeString(k.Key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		plain, err := beneficiary.Decrypt(enc)
+		if err != nil {
+			t.Fatalf("decrypt inherited key %s fail: %v", k.Id, err)
+		}
+		got = append(got, string(plain))
+	}
+	if len(got) != 2 || got[0] != "first seed phrase" || got[1] != "second seed phrase" {
+		t.Fatalf("inherited %q, want both seed phrases", got)
+	}
+}
+
+func TestInheritanceGatedByFreeze(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	ctx := context.Background()
+	storeTestKey(t, d, "wallet", "seed phrase")
+	_, pub := crypto.NewRsa("", "").CreatePkcs8Keys(1024)
+	req := &keylocker.SetInheritanceReq{
+		WalletUuid:     "wallet",
+		Password:       sealed(t, testPassword),
+		SocialCode:     sealed(t, testSocialCode),
+		BeneficiaryPub: pub,
+		Period:         3600,
+	}
+	if rep, err := d.SetInheritance(ctx, req); err != nil || rep.Code != keylocker.ReturnCode_ERROR {
+		t.Fatalf("a period under the minimum got %v %v, want ERROR", rep, err)
+	}
+	req.Period = defaultInheritanceMinPeriod
+	if rep, err := d.SetInheritance(ctx, req); err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("set inheritance fail: %v %v", rep, err)
+	}
+	if err := d.repo().FreezeWallet(ctx, "wallet", "stolen phone"); err != nil {
+		t.Fatal(err)
+	}
+
+	req.BeneficiaryPub = ""
+	if rep, err := d.SetInheritance(ctx, req); err != nil || rep.Code != keylocker.ReturnCode_FROZEN {
+		t.Fatalf("set inheritance got %v %v, want FROZEN", rep, err)
+	}
+	beat, err := d.Heartbeat(ctx, &keylocker.HeartbeatReq{
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+	})
+	if err != nil || beat.Code != keylocker.ReturnCode_FROZEN {
+		t.Fatalf("heartbeat got %v %v, want FROZEN", beat, err)
+	}
+	d.checkInheritance(ctx, time.Now().Add(2*defaultInheritanceMinPeriod*time.Second))
+	if rep, err := d.GetInheritedKeys(ctx, &keylocker.GetInheritedKeysReq{WalletUuid: "wallet"}); err != nil || rep.Code != keylocker.ReturnCode_ERROR {
+		t.Fatalf("the keys of a frozen wallet must not be released, got %v %v", rep, err)
+	}
+}
@@ -0,0 +1,54 @@
+package keydispatcher
+
+import (
+	"context"
+	"errors"
+
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"gorm.io/gorm"
+)
+
+// GetJobStatus returns the progress of the transaction of a write. It takes the admin token, or the
+// credentials of the wallet of the job, a job of another wallet is reported as not found.
+func (d *Dispatcher) GetJobStatus(ctx context.Context, req *keylocker.GetJobStatusReq) (*keylocker.GetJobStatusRep, error) {
+	admin := d.isAdmin(req.ConsumerToken)
+	if !admin {
+		if req.WalletUuid == "" {
+			return &keylocker.GetJobStatusRep{
+				Code: keylocker.ReturnCode_ERROR,
+				Msg:  "wallet_uuid is required",
+			}, nil
+		}
+		_, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode)
+		if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+			return &keylocker.GetJobStatusRep{
+				Code: code,
+				Msg:  err.Error(),
+			}, nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	job, err := d.repo().GetJob(ctx, req.JobId)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && !admin && job.KeyUuid != req.WalletUuid) {
+		return &keylocker.GetJobStatusRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  "job not found",
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &keylocker.GetJobStatusRep{
+		Code:                  keylocker.ReturnCode_SUCCESS,
+		Msg:                   "get job status success",
+		JobId:                 job.JobId,
+		Chain:                 job.Chain,
+		WalletUuid:            job.KeyUuid,
+		TxHash:                job.TxHash,
+		Status:                job.Status,
+		BlockNumber:           job.BlockNumber,
+		Confirmations:         job.Confirmations,
+		RequiredConfirmations: job.RequiredConfirmations,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package keydispatcher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+func TestGetJobStatusNeedsTheWallet(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	ctx := context.Background()
+	storeTestKey(t, d, "wallet", "seed phrase")
+	storeTestKey(t, d, "other", "other seed")
+	if err := d.repo().CreateJob(ctx, &model.Job{JobId: "job", KeyUuid: "wallet", Chain: testChain, TxHash: "0x01", Status: "pending"}); err != nil {
+		t.Fatal(err)
+	}
+	get := func(req *keylocker.GetJobStatusReq) *keylocker.GetJobStatusRep {
+		t.Helper()
+		rep, err := d.GetJobStatus(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rep
+	}
+
+	if rep := get(&keylocker.GetJobStatusReq{JobId: "job", WalletUuid: "wallet"}); rep.Code != keylocker.ReturnCode_INVALID_CREDENTIALS || rep.TxHash != "" {
+		t.Fatalf("get without credentials got %v, want INVALID_CREDENTIALS", rep)
+	}
+	if rep := get(&keylocker.GetJobStatusReq{
+		JobId:      "job",
+		WalletUuid: "other",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+	}); rep.Code != keylocker.ReturnCode_NOT_FOUND {
+		t.Fatalf("get the job of another wallet got %v, want NOT_FOUND", rep)
+	}
+	if rep := get(&keylocker.GetJobStatusReq{
+		JobId:      "job",
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+	}); rep.Code != keylocker.ReturnCode_SUCCESS || rep.TxHash != "0x01" {
+		t.Fatalf("get with the credentials got %v, want the job", rep)
+	}
+	if rep := get(&keylocker.GetJobStatusReq{ConsumerToken: testAdminToken, JobId: "missing"}); rep.Code != keylocker.ReturnCode_NOT_FOUND {
+		t.Fatalf("get a missing job got %v, want NOT_FOUND", rep)
+	}
+}
@@ -0,0 +1,78 @@
+package keydispatcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+// KeyStatusStored is the status of the keys stored without a transaction
+const KeyStatusStored = "stored"
+
+// ListSocialKeys returns the metadata of the keys stored for the wallet, never the keys themselves.
+// Like ExportSocialKeys it is an admin rpc.
+func (d *Dispatcher) ListSocialKeys(ctx context.Context, req *keylocker.ListSocialKeysReq) (*keylocker.ListSocialKeysRep, error) {
+	if !d.isAdmin(req.ConsumerToken) {
+		return &keylocker.ListSocialKeysRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  config.PermissionDenied,
+		}, nil
+	}
+	if req.WalletUuid == "" {
+		return &keylocker.ListSocialKeysRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	repo := d.repo()
+	keys, err := repo.ListKeysByUID(ctx, req.WalletUuid, req.Chain)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListKeysByUID fail, req, %v, err: [%w]", req, err)
+	}
+	txHashes := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if k.TxHash != "" {
+			txHashes = append(txHashes, k.TxHash)
+		}
+	}
+	jobs, err := repo.ListJobsByTxHashes(ctx, txHashes)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListJobsByTxHashes fail, req, %v, err: [%w]", req, err)
+	}
+	jobByTx := make(map[string]*model.Job, len(jobs))
+	for _, job := range jobs {
+		jobByTx[job.TxHash] = job
+	}
+
+	metas := make([]*keylocker.SocialKeyMeta, 0, len(keys))
+	for _, k := range keys {
+		meta := &keylocker.SocialKeyMeta{
+			Id:           k.KeyId,
+			Chain:        k.Chain,
+			FileCid:      k.KeyCID,
+			TxHash:       k.TxHash,
+			CreatedAt:    k.CreatedAt.Unix(),
+			Scheme:       k.Scheme,
+			Status:       KeyStatusStored,
+			Label:        k.Label,
+			Version:      k.Version,
+			SupersededBy: k.SupersededBy,
+		}
+		if job, ok := jobByTx[k.TxHash]; ok {
+			meta.Status = job.Status
+			meta.Confirmations = job.Confirmations
+			meta.RequiredConfirmations = job.RequiredConfirmations
+		} else if k.TxHash != "" {
+			meta.Status = model.JobStatusPending
+		}
+		metas = append(metas, meta)
+	}
+	return &keylocker.ListSocialKeysRep{
+		Code: keylocker.ReturnCode_SUCCESS,
+		Msg:  "list social keys success",
+		Keys: metas,
+	}, nil
+}
@@ -0,0 +1,362 @@
+package keydispatcher
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultChallengeTTL = 300 * time.Second
+	defaultSessionTTL   = 900 * time.Second
+
+	siweHeaderSuffix = " wants you to sign in with your Ethereum account:"
+	siweStatement    = "Sign in to the key locker to retrieve the keys of your wallet."
+)
+
+var errSessionRequired = errors.New("session token of a login of the owner address is required")
+
+// siweMessage is an EIP-4361 message, times are RFC 3339
+type siweMessage struct {
+	Domain         string
+	Address        string
+	Statement      string
+	Uri            string
+	Version        string
+	ChainId        int64
+	Nonce          string
+	IssuedAt       string
+	ExpirationTime string
+	NotBefore      string
+	RequestId      string
+	Resources      []string
+}
+
+// String formats the message the way it is signed
+func (m *siweMessage) String() string {
+	var b strings.Builder
+	b.WriteString(m.Domain + siweHeaderSuffix + "\n" + m.Address + "\n\n")
+	if m.Statement != "" {
+		b.WriteString(m.Statement + "\n\n")
+	}
+	fmt.Fprintf(&b, "URI: %s\nVersion: %s\nChain ID: %d\nNonce: %s\nIssued At: %s", m.Uri, m.Version, m.ChainId, m.Nonce, m.IssuedAt)
+	if m.ExpirationTime != "" {
+		b.WriteString("\nExpiration Time: " + m.ExpirationTime)
+	}
+	if m.NotBefore != "" {
+		b.WriteString("\nNot Before: " + m.NotBefore)
+	}
+	if m.RequestId != "" {
+		b.WriteString("\nRequest ID: " + m.RequestId)
+	}
+	if len(m.Resources) > 0 {
+		b.WriteString("\nResources:")
+		for _, r := range m.Resources {
+			b.WriteString("\n- " + r)
+		}
+	}
+	return b.String()
+}
+
+// parseSiweMessage parses and checks the syntax of an EIP-4361 message
+func parseSiweMessage(msg string) (*siweMessage, error) {
+	lines := strings.Split(msg, "\n")
+	if len(lines) < 3 || !strings.HasSuffix(lines[0], siweHeaderSuffix) || lines[2] != "" {
+		return nil, errors.New("not an EIP-4361 message")
+	}
+	m := &siweMessage{
+		Domain:  strings.TrimSuffix(lines[0], siweHeaderSuffix),
+		Address: lines[1],
+	}
+	if m.Domain == "" {
+		return nil, errors.New("domain is empty")
+	}
+	if !common.IsHexAddress(m.Address) || !strings.HasPrefix(m.Address, "0x") {
+		return nil, fmt.Errorf("invalid address %q", m.Address)
+	}
+	rest := lines[3:]
+	if len(rest) > 0 && !strings.HasPrefix(rest[0], "URI: ") {
+		if len(rest) < 2 || rest[1] != "" {
+			return nil, errors.New("statement must be followed by an empty line")
+		}
+		m.Statement, rest = rest[0], rest[2:]
+	}
+	var chainId string
+	fields := []struct {
+		tag      string
+		value    *string
+		required bool
+	}{
+		{"URI", &m.Uri, true},
+		{"Version", &m.Version, true},
+		{"Chain ID", &chainId, true},
+		{"Nonce", &m.Nonce, true},
+		{"Issued At", &m.IssuedAt, true},
+		{"Expiration Time", &m.ExpirationTime, false},
+		{"Not Before", &m.NotBefore, false},
+		{"Request ID", &m.RequestId, false},
+	}
+	for _, f := range fields {
+		if len(rest) > 0 && strings.HasPrefix(rest[0], f.tag+": ") {
+			*f.value, rest = strings.TrimPrefix(rest[0], f.tag+": "), rest[1:]
+		} else if f.required {
+			return nil, fmt.Errorf("%s is missing", f.tag)
+		}
+	}
+	if len(rest) > 0 && rest[0] == "Resources:" {
+		for _, r := range rest[1:] {
+			if !strings.HasPrefix(r, "- ") {
+				return nil, fmt.Errorf("invalid resource %q", r)
+			}
+			m.Resources = append(m.Resources, strings.TrimPrefix(r, "- "))
+		}
+		rest = nil
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("unexpected line %q", rest[0])
+	}
+
+	if m.Version != "1" {
+		return nil, fmt.Errorf("unsupported version %q", m.Version)
+	}
+	var err error
+	if m.ChainId, err = strconv.ParseInt(chainId, 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid chain id %q", chainId)
+	}
+	if len(m.Nonce) < 8 || strings.IndexFunc(m.Nonce, func(r rune) bool {
+		return !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z')
+	}) >= 0 {
+		return nil, fmt.Errorf("nonce must be at least 8 alphanumeric characters")
+	}
+	for _, t := range []string{m.IssuedAt, m.ExpirationTime, m.NotBefore} {
+		if _, err := time.Parse(time.RFC3339, t); t != "" && err != nil {
+			return nil, fmt.Errorf("invalid time %q", t)
+		}
+	}
+	return m, nil
+}
+
+// GetChallenge issues the EIP-4361 message the owner address of the wallet signs to login
+func (d *Dispatcher) GetChallenge(ctx context.Context, req *keylocker.GetChallengeReq) (*keylocker.GetChallengeRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.GetChallengeRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	conf := d.config().Siwe
+	if conf.Domain == "" {
+		return &keylocker.GetChallengeRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "login is disabled, siwe.domain is not set",
+		}, nil
+	}
+	repo := d.repo()
+	sec, err := repo.GetByUID(ctx, req.WalletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &keylocker.GetChallengeRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  errWalletNotFound.Error(),
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetByUID fail, req, %v, err: [%w]", req, err)
+	}
+	if sec.OwnerAddress == "" {
+		return &keylocker.GetChallengeRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet has no owner address, see setOwnerAddress",
+		}, nil
+	}
+
+	var nonce [16]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return nil, fmt.Errorf("generate nonce fail, err: [%w]", err)
+	}
+	ttl := time.Duration(conf.ChallengeTTL) * time.Second
+	if ttl <= 0 {
+		ttl = defaultChallengeTTL
+	}
+	uri, chainId := conf.Uri, conf.ChainId
+	if uri == "" {
+		uri = "https://" + conf.Domain
+	}
+	if chainId == 0 {
+		chainId = 1
+	}
+	now := time.Now().UTC()
+	msg := &siweMessage{
+		Domain:         conf.Domain,
+		Address:        sec.OwnerAddress,
+		Statement:      siweStatement,
+		Uri:            uri,
+		Version:        "1",
+		ChainId:        chainId,
+		Nonce:          hex.EncodeToString(nonce[:]),
+		IssuedAt:       now.Format(time.RFC3339),
+		ExpirationTime: now.Add(ttl).Format(time.RFC3339),
+	}
+	challenge := &model.LoginChallenge{
+		Nonce:     msg.Nonce,
+		KeyUuid:   req.WalletUuid,
+		Address:   sec.OwnerAddress,
+		Message:   msg.String(),
+		ExpiresAt: now.Add(ttl),
+	}
+	if err := repo.CreateLoginChallenge(ctx, challenge); err != nil {
+		return nil, fmt.Errorf("repo.CreateLoginChallenge fail, req, %v, err: [%w]", req, err)
+	}
+	return &keylocker.GetChallengeRep{
+		Code:      keylocker.ReturnCode_SUCCESS,
+		Msg:       "get challenge success",
+		Message:   challenge.Message,
+		Nonce:     challenge.Nonce,
+		ExpiresAt: challenge.ExpiresAt.Unix(),
+	}, nil
+}
+
+// Login checks the personal_sign signature of a challenge by the owner address of its wallet and opens a session
+func (d *Dispatcher) Login(ctx context.Context, req *keylocker.LoginReq) (*keylocker.LoginRep, error) {
+	msg, err := parseSiweMessage(req.Message)
+	if err != nil {
+		return &keylocker.LoginRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  fmt.Sprintf("invalid message: %v", err),
+		}, nil
+	}
+	repo := d.repo()
+	challenge, err := repo.GetLoginChallenge(ctx, msg.Nonce)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &keylocker.LoginRep{
+			Code: keylocker.ReturnCode_INVALID_CREDENTIALS,
+			Msg:  "unknown nonce, see getChallenge",
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetLoginChallenge fail, nonce, %s, err: [%w]", msg.Nonce, err)
+	}
+	now := time.Now()
+	// the message is compared as a whole, what the owner signed is exactly what was issued
+	if challenge.Message != req.Message || challenge.UsedAt != nil || !now.Before(challenge.ExpiresAt) {
+		return &keylocker.LoginRep{
+			Code: keylocker.ReturnCode_INVALID_CREDENTIALS,
+			Msg:  "challenge does not match, was used or expired",
+		}, nil
+	}
+	signer, err := recoverSigner(accounts.TextHash([]byte(req.Message)), req.Signature)
+	if err != nil || signer != common.HexToAddress(challenge.Address) {
+		return &keylocker.LoginRep{
+			Code: keylocker.ReturnCode_INVALID_CREDENTIALS,
+			Msg:  errInvalidSignature.Error(),
+		}, nil
+	}
+	sec, err := repo.GetByUID(ctx, challenge.KeyUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &keylocker.LoginRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  errWalletNotFound.Error(),
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetByUID fail, walletUuid, %s, err: [%w]", challenge.KeyUuid, err)
+	}
+	if sec.OwnerAddress != challenge.Address {
+		return &keylocker.LoginRep{
+			Code: keylocker.ReturnCode_INVALID_CREDENTIALS,
+			Msg:  "owner address changed since the challenge",
+		}, nil
+	}
+	ok, err := repo.UseLoginChallenge(ctx, challenge.Nonce, now)
+	if err != nil {
+		return nil, fmt.Errorf("repo.UseLoginChallenge fail, nonce, %s, err: [%w]", challenge.Nonce, err)
+	}
+	if !ok {
+		return &keylocker.LoginRep{
+			Code: keylocker.ReturnCode_INVALID_CREDENTIALS,
+			Msg:  "challenge does not match, was used or expired",
+		}, nil
+	}
+
+	if err := repo.DeleteExpiredLogins(ctx, challenge.KeyUuid, now); err != nil {
+		return nil, fmt.Errorf("repo.DeleteExpiredLogins fail, walletUuid, %s, err: [%w]", challenge.KeyUuid, err)
+	}
+	var token [32]byte
+	if _, err := rand.Read(token[:]); err != nil {
+		return nil, fmt.Errorf("generate session token fail, err: [%w]", err)
+	}
+	ttl := time.Duration(d.config().Siwe.SessionTTL) * time.Second
+	if ttl <= 0 {
+		ttl = defaultSessionTTL
+	}
+	session := &model.Session{
+		TokenHash:         hashSessionToken(hex.EncodeToString(token[:])),
+		KeyUuid:           challenge.KeyUuid,
+		Address:           challenge.Address,
+		CredentialVersion: sec.CredentialVersion,
+		ExpiresAt:         now.Add(ttl),
+	}
+	if err := repo.CreateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("repo.CreateSession fail, walletUuid, %s, err: [%w]", challenge.KeyUuid, err)
+	}
+	if err := d.audit(ctx, &model.AuditRecord{
+		Action:  model.AuditActionLogin,
+		KeyUuid: challenge.KeyUuid,
+	}, map[string]interface{}{
+		"address":    challenge.Address,
+		"expires_at": session.ExpiresAt.Unix(),
+	}); err != nil {
+		return nil, fmt.Errorf("audit fail, err: [%w]", err)
+	}
+	return &keylocker.LoginRep{
+		Code:         keylocker.ReturnCode_SUCCESS,
+		Msg:          "login success",
+		SessionToken: hex.EncodeToString(token[:]),
+		ExpiresAt:    session.ExpiresAt.Unix(),
+	}, nil
+}
+
+// checkSession requires a valid session token for the retrievals of a wallet with an owner address. A session
+// ends with its ttl, or once the credentials or the owner address of the wallet changed since the login.
+func (d *Dispatcher) checkSession(ctx context.Context, sec *model.Secret, token string) error {
+	if sec.OwnerAddress == "" {
+		return nil
+	}
+	if token == "" {
+		return errSessionRequired
+	}
+	session, err := d.repo().GetSession(ctx, hashSessionToken(token))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("%w: unknown session token", errSessionRequired)
+	}
+	if err != nil {
+		return fmt.Errorf("repo.GetSession fail, walletUuid, %s, err: [%w]", sec.KeyUuid, err)
+	}
+	switch {
+	case session.KeyUuid != sec.KeyUuid:
+		return fmt.Errorf("%w: session is for another wallet", errSessionRequired)
+	case !time.Now().Before(session.ExpiresAt):
+		return fmt.Errorf("%w: session expired", errSessionRequired)
+	case session.CredentialVersion != sec.CredentialVersion:
+		return fmt.Errorf("%w: credentials changed since the login", errSessionRequired)
+	case session.Address != sec.OwnerAddress:
+		return fmt.Errorf("%w: owner address changed since the login", errSessionRequired)
+	}
+	return nil
+}
+
+func hashSessionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,70 @@
+package keydispatcher
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// the example message of EIP-4361
+const siweExample = `service.org wants you to sign in with your Ethereum account:
+0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2
+
+I accept the ServiceOrg Terms of Service: https://service.org/tos
+
+URI: https://service.org/login
+Version: 1
+Chain ID: 1
+Nonce: 32891756
+Issued At: 2021-09-30T16:25:24Z
+Resources:
+- ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/
+- https://example.com/my-web2-claim.json`
+
+func TestSiweMessage(t *testing.T) {
+	m, err := parseSiweMessage(siweExample)
+	if err != nil {
+		t.Fatalf("parse fail: %v", err)
+	}
+	want := &siweMessage{
+		Domain:    "service.org",
+		Address:   "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2",
+		Statement: "I accept the ServiceOrg Terms of Service: https://service.org/tos",
+		Uri:       "https://service.org/login",
+		Version:   "1",
+		ChainId:   1,
+		Nonce:     "32891756",
+		IssuedAt:  "2021-09-30T16:25:24Z",
+		Resources: []string{
+			"ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/",
+			"https://example.com/my-web2-claim.json",
+		},
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Fatalf("got %+v, want %+v", m, want)
+	}
+	if m.String() != siweExample {
+		t.Fatalf("formatted message differs:\n%s", m.String())
+	}
+
+	m.Statement, m.Resources, m.ExpirationTime = "", nil, "2021-09-30T16:30:24Z"
+	if parsed, err := parseSiweMessage(m.String()); err != nil || !reflect.DeepEqual(parsed, m) {
+		t.Fatalf("message without statement: got %+v, %v", parsed, err)
+	}
+
+	for name, msg := range map[string]string{
+		"bad nonce":       strings.Replace(siweExample, "Nonce: 32891756", "Nonce: 1234", 1),
+		"bad version":     strings.Replace(siweExample, "Version: 1", "Version: 2", 1),
+		"missing chain":   strings.Replace(siweExample, "Chain ID: 1\n", "", 1),
+		"bad time":        strings.Replace(siweExample, "2021-09-30T16:25:24Z", "yesterday", 1),
+		"bad address":     strings.Replace(siweExample, "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2", "0x1234", 1),
+		"extra line":      strings.Replace(siweExample, "Resources:", "Foo: bar\nResources:", 1),
+		"no header":       strings.Replace(siweExample, " wants you to sign in", " signs in", 1),
+		"no blank line":   strings.Replace(siweExample, "tos\n\n", "tos\n", 1),
+		"resource format": siweExample + "\nhttps://example.com",
+	} {
+		if _, err := parseSiweMessage(msg); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
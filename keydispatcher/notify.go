@@ -0,0 +1,22 @@
+package keydispatcher
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/savour-labs/key-locker/notify"
+)
+
+// notify sends the event to the channels of the config in the background, a failing channel is only logged
+func (d *Dispatcher) notify(event *notify.Event) {
+	event.Time = time.Now().Unix()
+	log.Info("notify", "event", event.Type, "walletUuid", event.WalletUuid, "caseId", event.CaseId)
+	for _, n := range notify.New(&d.config().Notify) {
+		go func(n notify.Notifier) {
+			if err := n.Notify(context.Background(), event); err != nil {
+				log.Error("notify fail", "event", event.Type, "walletUuid", event.WalletUuid, "err", err)
+			}
+		}(n)
+	}
+}
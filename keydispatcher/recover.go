@@ -0,0 +1,108 @@
+package keydispatcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/crypto"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+// RecoverSocialKey unlocks the rsa key pair of the wallet with its credentials and returns the
+// selected keys of the chain decrypted. Wallets with guardians also need an approved recovery case.
+func (d *Dispatcher) RecoverSocialKey(ctx context.Context, req *keylocker.RecoverSocialKeyReq) (*keylocker.RecoverSocialKeyRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.RecoverSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	rsaObj, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode)
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.RecoverSocialKeyRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := d.checkFactors(ctx, req.WalletUuid, factors{sessionToken: req.SessionToken, totpCode: req.TotpCode, recoveryCode: req.RecoveryCode}); err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.RecoverSocialKeyRep{
+				Code: code,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	adaptor, ok := d.acquire(req.Chain)
+	if !ok {
+		return &keylocker.RecoverSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  config.UnsupportedOperation,
+		}, nil
+	}
+	defer adaptor.release()
+	rc, err := d.claimRecovery(ctx, req.WalletUuid, req.Chain, req.RecoveryCaseId)
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.RecoverSocialKeyRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	rep, err := d.recoverSocialKey(ctx, adaptor, rsaObj, req)
+	d.settleRecovery(ctx, rc, err == nil && rep.Code == keylocker.ReturnCode_SUCCESS)
+	return rep, err
+}
+
+func (d *Dispatcher) recoverSocialKey(ctx context.Context, adaptor *adaptorEntry, rsaObj *crypto.Rsa, req *keylocker.RecoverSocialKeyReq) (*keylocker.RecoverSocialKeyRep, error) {
+	rep, err := d.getSocialKey(ctx, adaptor, &keylocker.GetSocialKeyReq{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		WalletUuid:    req.WalletUuid,
+		FileCid:       req.FileCid,
+		KeyId:         req.KeyId,
+		Version:       req.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rep.Code != keylocker.ReturnCode_SUCCESS {
+		return &keylocker.RecoverSocialKeyRep{
+			Code: rep.Code,
+			Msg:  rep.Msg,
+		}, nil
+	}
+	if len(rep.KeyList) == 0 {
+		return &keylocker.RecoverSocialKeyRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  "no social key stored",
+		}, nil
+	}
+
+	keyList := make([]*keylocker.SocialKey, 0, len(rep.KeyList))
+	for _, k := range rep.KeyList {
+		plain, err := rsaObj.Decrypt([]byte(k.Key))
+		if err != nil {
+			return &keylocker.RecoverSocialKeyRep{
+				Code: keylocker.ReturnCode_ERROR,
+				Msg:  fmt.Sprintf("decrypt key %s version %d fail: %v", k.Id, k.Version, err),
+			}, nil
+		}
+		keyList = append(keyList, &keylocker.SocialKey{
+			Id:      k.Id,
+			Key:     string(plain),
+			Label:   k.Label,
+			Version: k.Version,
+		})
+	}
+	return &keylocker.RecoverSocialKeyRep{
+		Code:    keylocker.ReturnCode_SUCCESS,
+		Msg:     "recover social key success",
+		KeyList: keyList,
+	}, nil
+}
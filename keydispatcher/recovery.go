@@ -0,0 +1,431 @@
+package keydispatcher
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/notify"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultCaseTTL      = 7 * 24 * 3600
+	defaultMaxOpenCases = 3
+)
+
+var errApprovalRequired = errors.New("recovery case approved by the guardians is required")
+
+// RequestRecovery opens a recovery case for the keys of the wallet on the chain. The guardians approve it
+// by signing its typed data, and the owner is notified and can cancel it until its release time.
+// Anyone can request a recovery, so a wallet only has recovery.max_open_cases cases open at once.
+func (d *Dispatcher) RequestRecovery(ctx context.Context, req *keylocker.RequestRecoveryReq) (*keylocker.RequestRecoveryRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.RequestRecoveryRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	adaptor, ok := d.acquire(req.Chain)
+	if !ok {
+		return &keylocker.RequestRecoveryRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  config.UnsupportedOperation,
+		}, nil
+	}
+	defer adaptor.release()
+	if err := d.checkFrozen(ctx, req.WalletUuid); errors.Is(err, errWalletFrozen) {
+		return &keylocker.RequestRecoveryRep{
+			Code: keylocker.ReturnCode_FROZEN,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	repo := d.repo()
+	policy, _, err := repo.GetRecoveryPolicy(ctx, req.WalletUuid)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("repo.GetRecoveryPolicy fail, req, %v, err: [%w]", req, err)
+	}
+	delay, err := d.recoveryDelay(ctx, req.WalletUuid)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil && delay == 0 {
+		return &keylocker.RequestRecoveryRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  "wallet has no guardians nor recovery delay",
+		}, nil
+	}
+	now := time.Now()
+	maxOpen := d.config().Recovery.MaxOpenCases
+	if maxOpen <= 0 {
+		maxOpen = defaultMaxOpenCases
+	}
+	open, err := repo.CountActiveRecoveryCases(ctx, req.WalletUuid, now)
+	if err != nil {
+		return nil, fmt.Errorf("repo.CountActiveRecoveryCases fail, req, %v, err: [%w]", req, err)
+	}
+	if open >= int64(maxOpen) {
+		return &keylocker.RequestRecoveryRep{
+			Code: keylocker.ReturnCode_POLICY_DENIED,
+			Msg:  fmt.Sprintf("wallet has %d recovery cases open, cancel one or wait for it to expire", open),
+		}, nil
+	}
+	var id [32]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, fmt.Errorf("generate case id fail, err: [%w]", err)
+	}
+	ttl := d.config().Recovery.CaseTTL
+	if ttl <= 0 {
+		ttl = defaultCaseTTL
+	}
+	rc := &model.RecoveryCase{
+		CaseId:    hexutil.Encode(id[:]),
+		KeyUuid:   req.WalletUuid,
+		Chain:     req.Chain,
+		Status:    model.RecoveryStatusPending,
+		ReleaseAt: now.Add(time.Duration(delay) * time.Second),
+	}
+	rc.ExpiresAt = rc.ReleaseAt.Add(time.Duration(ttl) * time.Second)
+	var threshold uint32
+	if policy != nil {
+		rc.Status, threshold = model.RecoveryStatusOpen, policy.Threshold
+	}
+	if err := repo.CreateRecoveryCase(ctx, rc); err != nil {
+		return nil, fmt.Errorf("repo.CreateRecoveryCase fail, req, %v, err: [%w]", req, err)
+	}
+	d.notify(&notify.Event{
+		Type:       notify.EventRecoveryRequested,
+		WalletUuid: rc.KeyUuid,
+		Chain:      rc.Chain,
+		CaseId:     rc.CaseId,
+		ReleaseAt:  rc.ReleaseAt.Unix(),
+	})
+	info, err := d.recoveryCase(rc, threshold, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &keylocker.RequestRecoveryRep{
+		Code: keylocker.ReturnCode_SUCCESS,
+		Msg:  "request recovery success",
+		Case: info,
+	}, nil
+}
+
+// ApproveRecovery records the approval of the guardian who signed the typed data of the case,
+// the case is approved once the threshold of the wallet is reached
+func (d *Dispatcher) ApproveRecovery(ctx context.Context, req *keylocker.ApproveRecoveryReq) (*keylocker.ApproveRecoveryRep, error) {
+	repo := d.repo()
+	rc, err := repo.GetRecoveryCase(ctx, req.CaseId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &keylocker.ApproveRecoveryRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  "recovery case not found",
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetRecoveryCase fail, req, %v, err: [%w]", req, err)
+	}
+	if rc.Status == model.RecoveryStatusReleased || rc.Status == model.RecoveryStatusCancelled || time.Now().After(rc.ExpiresAt) {
+		return &keylocker.ApproveRecoveryRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "recovery case is closed",
+		}, nil
+	}
+	policy, guardians, err := repo.GetRecoveryPolicy(ctx, rc.KeyUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &keylocker.ApproveRecoveryRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet has no guardians",
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetRecoveryPolicy fail, req, %v, err: [%w]", req, err)
+	}
+
+	_, digest, err := d.recoveryTypedData(rc)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := recoverSigner(digest, req.Signature)
+	if err != nil {
+		return &keylocker.ApproveRecoveryRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  fmt.Sprintf("invalid signature: %v", err),
+		}, nil
+	}
+	if !isGuardian(guardians, signer) {
+		return &keylocker.ApproveRecoveryRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  fmt.Sprintf("%s is not a guardian of the wallet", signer.Hex()),
+		}, nil
+	}
+	if err := repo.AddRecoveryApproval(ctx, &model.RecoveryApproval{
+		CaseId:    rc.CaseId,
+		Guardian:  signer.Hex(),
+		Signature: req.Signature,
+	}); err != nil {
+		return nil, fmt.Errorf("repo.AddRecoveryApproval fail, req, %v, err: [%w]", req, err)
+	}
+	approvers, err := repo.ListRecoveryApprovers(ctx, rc.CaseId)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListRecoveryApprovers fail, req, %v, err: [%w]", req, err)
+	}
+	if rc.Status == model.RecoveryStatusOpen && countApprovals(guardians, approvers) >= int(policy.Threshold) {
+		if _, err := repo.UpdateRecoveryStatus(ctx, rc.CaseId, model.RecoveryStatusOpen, model.RecoveryStatusPending); err != nil {
+			return nil, fmt.Errorf("repo.UpdateRecoveryStatus fail, req, %v, err: [%w]", req, err)
+		}
+		rc.Status = model.RecoveryStatusPending
+	}
+	info, err := d.recoveryCase(rc, policy.Threshold, approvers)
+	if err != nil {
+		return nil, err
+	}
+	return &keylocker.ApproveRecoveryRep{
+		Code: keylocker.ReturnCode_SUCCESS,
+		Msg:  "approve recovery success",
+		Case: info,
+	}, nil
+}
+
+func (d *Dispatcher) GetRecoveryCase(ctx context.Context, req *keylocker.GetRecoveryCaseReq) (*keylocker.GetRecoveryCaseRep, error) {
+	rc, err := d.repo().GetRecoveryCase(ctx, req.CaseId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &keylocker.GetRecoveryCaseRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  "recovery case not found",
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetRecoveryCase fail, req, %v, err: [%w]", req, err)
+	}
+	info, err := d.recoveryCaseInfo(ctx, rc)
+	if err != nil {
+		return nil, err
+	}
+	return &keylocker.GetRecoveryCaseRep{
+		Code: keylocker.ReturnCode_SUCCESS,
+		Msg:  "get recovery case success",
+		Case: info,
+	}, nil
+}
+
+// claimRecovery checks that the keys of a wallet with guardians or a recovery delay are released through
+// a pending case of the wallet and chain whose release time has passed, and marks the case released so that
+// it can be used once. It returns a nil case for the other wallets.
+func (d *Dispatcher) claimRecovery(ctx context.Context, walletUuid, chain, caseId string) (*model.RecoveryCase, error) {
+	if err := d.checkFrozen(ctx, walletUuid); err != nil {
+		return nil, err
+	}
+	repo := d.repo()
+	policy, guardians, err := repo.GetRecoveryPolicy(ctx, walletUuid)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("repo.GetRecoveryPolicy fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	delay, err := d.recoveryDelay(ctx, walletUuid)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil && delay == 0 {
+		return nil, nil
+	}
+	if caseId == "" {
+		return nil, errApprovalRequired
+	}
+	rc, err := repo.GetRecoveryCase(ctx, caseId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: case %s not found", errApprovalRequired, caseId)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetRecoveryCase fail, caseId, %s, err: [%w]", caseId, err)
+	}
+	if rc.KeyUuid != walletUuid || rc.Chain != chain {
+		return nil, fmt.Errorf("%w: case %s is not for this wallet and chain", errApprovalRequired, caseId)
+	}
+	now := time.Now()
+	if now.After(rc.ExpiresAt) {
+		return nil, fmt.Errorf("%w: case %s expired", errApprovalRequired, caseId)
+	}
+	if rc.Status != model.RecoveryStatusPending {
+		return nil, fmt.Errorf("%w: case %s is %s", errApprovalRequired, caseId, rc.Status)
+	}
+	if now.Before(rc.ReleaseAt) {
+		return nil, fmt.Errorf("%w: case %s can be released from %s", errApprovalRequired, caseId, rc.ReleaseAt.UTC().Format(time.RFC3339))
+	}
+	if policy != nil {
+		// the guardians may have changed since the case was approved
+		approvers, err := repo.ListRecoveryApprovers(ctx, caseId)
+		if err != nil {
+			return nil, fmt.Errorf("repo.ListRecoveryApprovers fail, caseId, %s, err: [%w]", caseId, err)
+		}
+		if n := countApprovals(guardians, approvers); n < int(policy.Threshold) {
+			return nil, fmt.Errorf("%w: case %s has %d of %d approvals", errApprovalRequired, caseId, n, policy.Threshold)
+		}
+	}
+	ok, err := repo.UpdateRecoveryStatus(ctx, caseId, model.RecoveryStatusPending, model.RecoveryStatusReleased)
+	if err != nil {
+		return nil, fmt.Errorf("repo.UpdateRecoveryStatus fail, caseId, %s, err: [%w]", caseId, err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: case %s is already released or cancelled", errApprovalRequired, caseId)
+	}
+	return rc, nil
+}
+
+// settleRecovery notifies the owner of a released case, and gives the case back when the keys could not be released
+func (d *Dispatcher) settleRecovery(ctx context.Context, rc *model.RecoveryCase, released bool) {
+	if rc == nil {
+		return
+	}
+	if released {
+		d.notify(&notify.Event{Type: notify.EventRecoveryReleased, WalletUuid: rc.KeyUuid, Chain: rc.Chain, CaseId: rc.CaseId})
+		return
+	}
+	if _, err := d.repo().UpdateRecoveryStatus(ctx, rc.CaseId, model.RecoveryStatusReleased, model.RecoveryStatusPending); err != nil {
+		log.Error("reopen recovery case fail", "caseId", rc.CaseId, "err", err)
+	}
+}
+
+// recoveryTypedData is the EIP-712 message the guardians sign to approve the case
+func (d *Dispatcher) recoveryTypedData(rc *model.RecoveryCase) (apitypes.TypedData, []byte, error) {
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": eip712DomainType,
+			"Recovery": {
+				{Name: "caseId", Type: "bytes32"},
+				{Name: "walletUuid", Type: "string"},
+				{Name: "chain", Type: "string"},
+				{Name: "expiresAt", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Recovery",
+		Domain:      d.eip712Domain(),
+		Message: apitypes.TypedDataMessage{
+			"caseId":     rc.CaseId,
+			"walletUuid": rc.KeyUuid,
+			"chain":      rc.Chain,
+			"expiresAt":  strconv.FormatInt(rc.ExpiresAt.Unix(), 10),
+		},
+	}
+	digest, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return typedData, nil, fmt.Errorf("hash typed data fail, caseId, %s, err: [%w]", rc.CaseId, err)
+	}
+	return typedData, digest, nil
+}
+
+var eip712DomainType = []apitypes.Type{
+	{Name: "name", Type: "string"},
+	{Name: "version", Type: "string"},
+	{Name: "chainId", Type: "uint256"},
+}
+
+// eip712Domain is the domain of the typed data signed for the locker, on the chain id of the recovery config
+func (d *Dispatcher) eip712Domain() apitypes.TypedDataDomain {
+	chainId := d.config().Recovery.ChainId
+	if chainId == 0 {
+		chainId = 1
+	}
+	return apitypes.TypedDataDomain{
+		Name:    "KeyLocker",
+		Version: "1",
+		ChainId: (*math.HexOrDecimal256)(big.NewInt(chainId)),
+	}
+}
+
+// recoveryCaseInfo returns the case with the threshold of the wallet and the guardians who approved it
+func (d *Dispatcher) recoveryCaseInfo(ctx context.Context, rc *model.RecoveryCase) (*keylocker.RecoveryCase, error) {
+	repo := d.repo()
+	var threshold uint32
+	policy, _, err := repo.GetRecoveryPolicy(ctx, rc.KeyUuid)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("repo.GetRecoveryPolicy fail, caseId, %s, err: [%w]", rc.CaseId, err)
+	}
+	if policy != nil {
+		threshold = policy.Threshold
+	}
+	approvers, err := repo.ListRecoveryApprovers(ctx, rc.CaseId)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListRecoveryApprovers fail, caseId, %s, err: [%w]", rc.CaseId, err)
+	}
+	return d.recoveryCase(rc, threshold, approvers)
+}
+
+func (d *Dispatcher) recoveryCase(rc *model.RecoveryCase, threshold uint32, approvers []string) (*keylocker.RecoveryCase, error) {
+	typedData, digest, err := d.recoveryTypedData(rc)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("marshal typed data fail, caseId, %s, err: [%w]", rc.CaseId, err)
+	}
+	return &keylocker.RecoveryCase{
+		CaseId:     rc.CaseId,
+		Chain:      rc.Chain,
+		WalletUuid: rc.KeyUuid,
+		Status:     rc.Status,
+		ExpiresAt:  rc.ExpiresAt.Unix(),
+		ReleaseAt:  rc.ReleaseAt.Unix(),
+		Threshold:  threshold,
+		Approvals:  approvers,
+		TypedData:  string(data),
+		Digest:     hexutil.Encode(digest),
+	}, nil
+}
+
+// recoverSigner returns the address of the secp256k1 signature over the digest,
+// v may be 0/1 or 27/28 as returned by eth_signTypedData_v4
+func recoverSigner(digest []byte, signature string) (common.Address, error) {
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(sig) != 65 {
+		return common.Address{}, fmt.Errorf("signature must be 65 bytes, got %d", len(sig))
+	}
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	pub, err := ethcrypto.SigToPub(digest, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return ethcrypto.PubkeyToAddress(*pub), nil
+}
+
+func isGuardian(guardians []string, addr common.Address) bool {
+	for _, g := range guardians {
+		if common.HexToAddress(g) == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// countApprovals counts the approvers who are still guardians of the wallet
+func countApprovals(guardians, approvers []string) int {
+	n := 0
+	for _, a := range approvers {
+		if isGuardian(guardians, common.HexToAddress(a)) {
+			n++
+		}
+	}
+	return n
+}
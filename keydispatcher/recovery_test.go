@@ -0,0 +1,97 @@
+package keydispatcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+func TestRecoverSigner(t *testing.T) {
+	d := &Dispatcher{conf: &config.Config{}}
+	rc := &model.RecoveryCase{
+		CaseId:    hexutil.Encode(make([]byte, 32)),
+		KeyUuid:   "wallet",
+		Chain:     "Ethereum",
+		ExpiresAt: time.Unix(1700000000, 0),
+	}
+	_, digest, err := d.recoveryTypedData(rc)
+	if err != nil {
+		t.Fatalf("typed data fail: %v", err)
+	}
+	key, _ := ethcrypto.GenerateKey()
+	sig, err := ethcrypto.Sign(digest, key)
+	if err != nil {
+		t.Fatalf("sign fail: %v", err)
+	}
+	// wallets return v as 27/28
+	sig[64] += 27
+	signer, err := recoverSigner(digest, hexutil.Encode(sig))
+	if err != nil {
+		t.Fatalf("recover fail: %v", err)
+	}
+	if signer != ethcrypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatalf("signer %s is not the signing key", signer.Hex())
+	}
+
+	// an approval of another case or chain id must not count
+	rc.Chain = "Moonbeam"
+	_, other, err := d.recoveryTypedData(rc)
+	if err != nil {
+		t.Fatalf("typed data fail: %v", err)
+	}
+	if signer, err := recoverSigner(other, hexutil.Encode(sig)); err == nil && signer == ethcrypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatal("signature must not verify for another case")
+	}
+	d.conf.Recovery.ChainId = 1284
+	rc.Chain = "Ethereum"
+	if _, other, _ = d.recoveryTypedData(rc); hexutil.Encode(other) == hexutil.Encode(digest) {
+		t.Fatal("chain id must be part of the digest")
+	}
+	if _, err := recoverSigner(digest, "0x1234"); err == nil {
+		t.Fatal("short signature must fail")
+	}
+}
+
+func TestCountApprovals(t *testing.T) {
+	guardians := []string{"0x00000000000000000000000000000000000000a1", "0x00000000000000000000000000000000000000A2"}
+	approvers := []string{"0x00000000000000000000000000000000000000A1", "0x00000000000000000000000000000000000000b3"}
+	if n := countApprovals(guardians, approvers); n != 1 {
+		t.Fatalf("expected 1 approval of a current guardian, got %d", n)
+	}
+}
+
+func TestRequestRecoveryLimitsOpenCases(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	d.conf.Recovery.MaxOpenCases = 2
+	ctx := context.Background()
+	if _, err := d.repo().SetRecoveryDelay(ctx, "wallet", 3600, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	req := &keylocker.RequestRecoveryReq{Chain: testChain, WalletUuid: "wallet"}
+	var caseId string
+	for i := 0; i < 2; i++ {
+		rep, err := d.RequestRecovery(ctx, req)
+		if err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+			t.Fatalf("request %d fail: %v %v", i, rep, err)
+		}
+		caseId = rep.Case.CaseId
+	}
+	rep, err := d.RequestRecovery(ctx, req)
+	if err != nil || rep.Code != keylocker.ReturnCode_POLICY_DENIED {
+		t.Fatalf("third request got %v %v, want POLICY_DENIED", rep, err)
+	}
+
+	// a closed case frees its place
+	if _, err := d.repo().UpdateRecoveryStatus(ctx, caseId, model.RecoveryStatusPending, model.RecoveryStatusCancelled); err != nil {
+		t.Fatal(err)
+	}
+	if rep, err := d.RequestRecovery(ctx, req); err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("request after a cancel fail: %v %v", rep, err)
+	}
+}
@@ -0,0 +1,92 @@
+package keydispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/notify"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+var errInvalidRecoveryCode = errors.New("unknown or used recovery code")
+
+// GenerateRecoveryCodes replaces the recovery codes of the wallet by ten new ones, returned only this once
+func (d *Dispatcher) GenerateRecoveryCodes(ctx context.Context, req *keylocker.GenerateRecoveryCodesReq) (*keylocker.GenerateRecoveryCodesRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.GenerateRecoveryCodesRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	_, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode)
+	if err == nil {
+		// the codes replace the other factors, issuing them takes the same factors
+		err = d.checkFactors(ctx, req.WalletUuid, factors{sessionToken: req.SessionToken, totpCode: req.TotpCode, recoveryCode: req.RecoveryCode})
+	}
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.GenerateRecoveryCodesRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	codes, err := d.issueOneTimeCodes(ctx, req.WalletUuid, model.CodeKindRecovery)
+	if err != nil {
+		return nil, err
+	}
+	d.notify(&notify.Event{Type: notify.EventRecoveryCodesGenerated, WalletUuid: req.WalletUuid})
+	return &keylocker.GenerateRecoveryCodesRep{
+		Code:  keylocker.ReturnCode_SUCCESS,
+		Msg:   "generate recovery codes success",
+		Codes: codes,
+	}, nil
+}
+
+// CountRecoveryCodes returns how many recovery codes of the wallet are left
+func (d *Dispatcher) CountRecoveryCodes(ctx context.Context, req *keylocker.CountRecoveryCodesReq) (*keylocker.CountRecoveryCodesRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.CountRecoveryCodesRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	if _, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode); err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.CountRecoveryCodesRep{
+				Code: code,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	count, err := d.repo().CountOneTimeCodes(ctx, req.WalletUuid, model.CodeKindRecovery)
+	if err != nil {
+		return nil, fmt.Errorf("repo.CountOneTimeCodes fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	return &keylocker.CountRecoveryCodesRep{
+		Code:      keylocker.ReturnCode_SUCCESS,
+		Msg:       "count recovery codes success",
+		Remaining: uint32(count),
+	}, nil
+}
+
+// redeemRecoveryCode burns the recovery code of the wallet, wrong codes count towards its lock
+func (d *Dispatcher) redeemRecoveryCode(ctx context.Context, walletUuid, code string) error {
+	now := time.Now()
+	ok, err := d.repo().UseOneTimeCode(ctx, walletUuid, model.CodeKindRecovery, hashOneTimeCode(walletUuid, normalizeCode(code)), now)
+	if err != nil {
+		return fmt.Errorf("repo.UseOneTimeCode fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	if !ok {
+		if err := d.countFailure(ctx, walletUuid, now); err != nil {
+			return err
+		}
+		return errInvalidRecoveryCode
+	}
+	d.notify(&notify.Event{Type: notify.EventRecoveryCodeUsed, WalletUuid: walletUuid})
+	return nil
+}
@@ -0,0 +1,258 @@
+package keydispatcher
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/savour-labs/key-locker/blockchain"
+	"github.com/savour-labs/key-locker/blockchain/ethereum"
+	"github.com/savour-labs/key-locker/blockchain/ipfs"
+	"github.com/savour-labs/key-locker/blockchain/moonbeam"
+	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/db"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/policy"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+// adaptorEntry is a registered adaptor together with the config it was built from
+// and the requests currently running on it
+type adaptorEntry struct {
+	blockchain.KeyAdaptor
+	conf     adaptorConf
+	inflight sync.WaitGroup
+}
+
+func newAdaptorEntry(adaptor blockchain.KeyAdaptor, conf adaptorConf) *adaptorEntry {
+	return &adaptorEntry{KeyAdaptor: adaptor, conf: conf}
+}
+
+func (e *adaptorEntry) release() {
+	e.inflight.Done()
+}
+
+// retire waits for the in-flight requests to drain and closes the adaptor
+func (e *adaptorEntry) retire(chain string) {
+	e.inflight.Wait()
+	if closer, ok := e.KeyAdaptor.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			log.Error("close retired adaptor fail", "chain", chain, "err", err)
+			return
+		}
+	}
+	log.Info("retired adaptor", "chain", chain)
+}
+
+// requestGen counts the requests started between two reloads, each of which may hold the repo of the dispatcher
+// at its start or any repo swapped in while it runs. drained is closed once the generation ended and its requests
+// and those of every earlier generation are done, from then on no request holds a repo swapped out before.
+type requestGen struct {
+	inflight sync.WaitGroup
+	prev     *requestGen
+	drained  chan struct{}
+}
+
+func newRequestGen(prev *requestGen) *requestGen {
+	return &requestGen{prev: prev, drained: make(chan struct{})}
+}
+
+// end closes drained in the background once the requests drain, no request may be added to the generation after it
+func (g *requestGen) end() {
+	go func() {
+		g.inflight.Wait()
+		if g.prev != nil {
+			<-g.prev.drained
+			g.prev = nil
+		}
+		close(g.drained)
+	}()
+}
+
+// begin marks a request in flight in the current generation, the caller must call the returned func once it is done
+func (d *Dispatcher) begin() func() {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	g := d.requests
+	g.inflight.Add(1)
+	return g.inflight.Done
+}
+
+// Close drains the in-flight requests and closes every adaptor and the database pool
+func (d *Dispatcher) Close(ctx context.Context) error {
+	d.mu.Lock()
+	registry, keyRepo, requests := d.registry, d.keyRepo, d.requests
+	d.registry = make(map[ChainType]*adaptorEntry)
+	d.requests = newRequestGen(requests)
+	d.mu.Unlock()
+	requests.end()
+
+	var wg sync.WaitGroup
+	for c, entry := range registry {
+		wg.Add(1)
+		go func(chain string, entry *adaptorEntry) {
+			defer wg.Done()
+			entry.retire(chain)
+		}(c, entry)
+	}
+	retired := make(chan struct{})
+	go func() {
+		wg.Wait()
+		<-requests.drained
+		close(retired)
+	}()
+	select {
+	case <-retired:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return keyRepo.Close()
+}
+
+// adaptorConf is the part of the config an adaptor is built from, an adaptor is only
+// rebuilt on reload when this part changes
+type adaptorConf struct {
+	NetWork  string
+	AesKey   string
+	Database *config.Database
+	Fullnode interface{}
+	Plugin   string
+}
+
+func adaptorConfig(conf *config.Config, chain string) adaptorConf {
+	ac := adaptorConf{
+		NetWork:  conf.NetWork,
+		AesKey:   conf.AesKey,
+		Database: conf.Database,
+	}
+	switch chain {
+	case ethereum.ChainName, moonbeam.ChainName:
+		ac.Fullnode = conf.Fullnode.Eth
+	case ipfs.ChainName:
+		ac.Fullnode = conf.Fullnode.Ipfs
+	default:
+		ac.Fullnode = conf.Fullnode
+	}
+	factoryMu.RLock()
+	ac.Plugin = pluginPaths[chain]
+	factoryMu.RUnlock()
+	return ac
+}
+
+// Reload loads the config file again and swaps in rebuilt adaptors for the chains whose
+// config changed. Requests already running on a replaced adaptor finish on it before it is closed.
+func (d *Dispatcher) Reload() ([]string, error) {
+	d.reloadMu.Lock()
+	defer d.reloadMu.Unlock()
+
+	d.mu.RLock()
+	oldConf, oldRepo, oldRegistry := d.conf, d.keyRepo, d.registry
+	d.mu.RUnlock()
+
+	var conf config.Config
+	if err := config.LoadConfigFile(oldConf.FilePath, &conf); err != nil {
+		return nil, fmt.Errorf("load config fail, path, %s, err: [%w]", oldConf.FilePath, err)
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, fmt.Errorf("validate config fail, path, %s, err: [%w]", oldConf.FilePath, err)
+	}
+	credPolicy, err := policy.Load(conf.Credentials)
+	if err != nil {
+		return nil, fmt.Errorf("load credential policy fail, path, %s, err: [%w]", oldConf.FilePath, err)
+	}
+	if !reflect.DeepEqual(oldConf.RpcServer, conf.RpcServer) {
+		log.Warn("rpcserver config changed, restart to apply it")
+	}
+
+	registerPlugins(conf.PluginDir)
+	registry := make(map[ChainType]*adaptorEntry)
+	var rebuilt []string
+	for _, c := range conf.Chains {
+		factory, ok := lookupFactory(c)
+		if !ok {
+			log.Error("unsupported chain", "chain", c, "supportedChains", supportedChains())
+			continue
+		}
+		ac := adaptorConfig(&conf, c)
+		if entry, ok := oldRegistry[c]; ok && reflect.DeepEqual(entry.conf, ac) {
+			registry[c] = entry
+			continue
+		}
+		adaptor, err := factory(&conf)
+		if err != nil {
+			for _, r := range rebuilt {
+				go registry[r].retire(r)
+			}
+			return nil, fmt.Errorf("setup chain fail, chain, %s, err: [%w]", c, err)
+		}
+		registry[c] = newAdaptorEntry(adaptor, ac)
+		rebuilt = append(rebuilt, c)
+	}
+
+	keyRepo := oldRepo
+	if !reflect.DeepEqual(oldConf.Database, conf.Database) {
+		keyRepo = model.NewRepo(db.InitDB(conf.Database))
+	}
+
+	d.mu.Lock()
+	requests := d.requests
+	d.conf, d.keyRepo, d.registry, d.credPolicy = &conf, keyRepo, registry, credPolicy
+	d.requests = newRequestGen(requests)
+	d.mu.Unlock()
+	requests.end()
+
+	var retiring sync.WaitGroup
+	for c, entry := range oldRegistry {
+		if registry[c] != entry {
+			retiring.Add(1)
+			go func(chain string, entry *adaptorEntry) {
+				defer retiring.Done()
+				entry.retire(chain)
+			}(c, entry)
+		}
+	}
+	if keyRepo != oldRepo {
+		// the adaptors are rebuilt with the database, the old pool is unused once they and the requests
+		// started before the swap, which may hold it from repo, drained
+		go func() {
+			retiring.Wait()
+			<-requests.drained
+			if err := oldRepo.Close(); err != nil {
+				log.Error("close retired database pool fail", "err", err)
+			}
+		}()
+	}
+	log.Info("config reloaded", "path", conf.FilePath, "rebuilt", rebuilt)
+	return rebuilt, nil
+}
+
+// isAdmin checks the consumer token against rpcserver.admin_token, admin rpcs are disabled while it is empty
+func (d *Dispatcher) isAdmin(consumerToken string) bool {
+	token := d.config().RpcServer.AdminToken
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(consumerToken)) == 1
+}
+
+func (d *Dispatcher) ReloadConfig(ctx context.Context, req *keylocker.ReloadConfigReq) (*keylocker.ReloadConfigRep, error) {
+	if !d.isAdmin(req.ConsumerToken) {
+		return &keylocker.ReloadConfigRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  config.PermissionDenied,
+		}, nil
+	}
+	rebuilt, err := d.Reload()
+	if err != nil {
+		return &keylocker.ReloadConfigRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, nil
+	}
+	return &keylocker.ReloadConfigRep{
+		Code:          keylocker.ReturnCode_SUCCESS,
+		Msg:           "reload config success",
+		RebuiltChains: rebuilt,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package keydispatcher
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSwappedRepoWaitsForEarlierRequests(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	// a request started before two reloads may hold the repo swapped out by either of them
+	done := d.begin()
+	first := d.requests
+	second := newRequestGen(first)
+	first.end()
+	d.requests = newRequestGen(second)
+	second.end()
+
+	select {
+	case <-second.drained:
+		t.Fatal("the second generation drained while a request of the first one runs")
+	case <-time.After(50 * time.Millisecond):
+	}
+	done()
+	select {
+	case <-second.drained:
+	case <-time.After(time.Second):
+		t.Fatal("the second generation did not drain once the request was done")
+	}
+}
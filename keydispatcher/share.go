@@ -0,0 +1,535 @@
+package keydispatcher
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/google/uuid"
+	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/crypto"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"gorm.io/gorm"
+)
+
+// StoreShares parks the TSS shares of a wallet, each share on the backend of its chain
+func (d *Dispatcher) StoreShares(ctx context.Context, req *keylocker.StoreSharesReq) (*keylocker.StoreSharesRep, error) {
+	return d.storeShares(ctx, req, "")
+}
+
+// RefreshShares replaces the active share set after a proactive refresh. Every share keeps its index and
+// chain, the new set only becomes active once all of its shares are stored, then the old shares are pruned.
+func (d *Dispatcher) RefreshShares(ctx context.Context, req *keylocker.RefreshSharesReq) (*keylocker.RefreshSharesRep, error) {
+	if req.SetId == "" {
+		return &keylocker.RefreshSharesRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "set_id is required",
+		}, nil
+	}
+	rep, err := d.storeShares(ctx, &keylocker.StoreSharesReq{
+		ConsumerToken: req.ConsumerToken,
+		WalletUuid:    req.WalletUuid,
+		Password:      req.Password,
+		SocialCode:    req.SocialCode,
+		Shares:        req.Shares,
+	}, req.SetId)
+	if err != nil {
+		return nil, err
+	}
+	return &keylocker.RefreshSharesRep{
+		Code:       rep.Code,
+		Msg:        rep.Msg,
+		SetId:      rep.SetId,
+		Epoch:      rep.Epoch,
+		Shares:     rep.Shares,
+		Violations: rep.Violations,
+	}, nil
+}
+
+func (d *Dispatcher) storeShares(ctx context.Context, req *keylocker.StoreSharesReq, replaceSetId string) (*keylocker.StoreSharesRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.StoreSharesRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	if err := checkShares(req.Shares); err != nil {
+		return &keylocker.StoreSharesRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, nil
+	}
+	repo := d.repo()
+	for _, in := range req.Shares {
+		if in.Policy != model.SharePolicyGuardians {
+			continue
+		}
+		if _, _, err := repo.GetRecoveryPolicy(ctx, req.WalletUuid); errors.Is(err, gorm.ErrRecordNotFound) {
+			return &keylocker.StoreSharesRep{
+				Code: keylocker.ReturnCode_ERROR,
+				Msg:  "the guardians policy needs guardians on the wallet",
+			}, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("repo.GetRecoveryPolicy fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+		}
+		break
+	}
+	// the first share stored creates the wallet like setSocialKey does
+	_, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode)
+	if err == nil || errors.Is(err, errWalletNotFound) {
+		err = d.checkStoreCredentials(ctx, req.WalletUuid, req.Password, req.SocialCode)
+	}
+	if err == nil {
+		// a refresh prunes the shares of the active set, which the freeze protects like the keys
+		err = d.checkFrozen(ctx, req.WalletUuid)
+	}
+	if err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.StoreSharesRep{
+				Code:       code,
+				Msg:        err.Error(),
+				Violations: policyViolations(err),
+			}, nil
+		}
+		return nil, err
+	}
+
+	var epoch uint64
+	prev := make(map[uint32]*model.Share)
+	active, err := repo.GetActiveShareSet(ctx, req.WalletUuid)
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		if replaceSetId != "" {
+			return &keylocker.StoreSharesRep{
+				Code: keylocker.ReturnCode_NOT_FOUND,
+				Msg:  "wallet has no share set",
+			}, nil
+		}
+	case err != nil:
+		return nil, fmt.Errorf("repo.GetActiveShareSet fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	case replaceSetId == "":
+		return &keylocker.StoreSharesRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet has a share set, refresh it instead",
+		}, nil
+	case active.SetId != replaceSetId:
+		return &keylocker.StoreSharesRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "set_id is not the active share set",
+		}, nil
+	default:
+		epoch = active.Epoch
+		shares, err := repo.ListShares(ctx, active.SetId)
+		if err != nil {
+			return nil, fmt.Errorf("repo.ListShares fail, setId, %s, err: [%w]", active.SetId, err)
+		}
+		for _, s := range shares {
+			prev[s.ShareIndex] = s
+		}
+		if err := sameShareLayout(prev, req.Shares); err != nil {
+			return &keylocker.StoreSharesRep{
+				Code: keylocker.ReturnCode_ERROR,
+				Msg:  err.Error(),
+			}, nil
+		}
+	}
+
+	var rep *keylocker.StoreSharesRep
+	err = d.withSocialCode(ctx, req.WalletUuid, req.SocialCode, func() (bool, error) {
+		res, written, err := d.writeShares(ctx, req, epoch, prev, replaceSetId)
+		rep = res
+		return written > 0, err
+	})
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.StoreSharesRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return rep, nil
+}
+
+// writeShares writes the shares as a new set and activates it in place of replaceSetId, it also returns how many
+// shares it wrote to the backends
+func (d *Dispatcher) writeShares(ctx context.Context, req *keylocker.StoreSharesReq, epoch uint64, prev map[uint32]*model.Share, replaceSetId string) (*keylocker.StoreSharesRep, int, error) {
+	repo := d.repo()
+	set := &model.ShareSet{
+		SetId:   uuid.NewString(),
+		KeyUuid: req.WalletUuid,
+		Epoch:   epoch + 1,
+		Status:  model.ShareSetStatusPending,
+	}
+	if err := repo.CreateShareSet(ctx, set); err != nil {
+		return nil, 0, fmt.Errorf("repo.CreateShareSet fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	shares := make([]*model.Share, 0, len(req.Shares))
+	for _, in := range req.Shares {
+		share, rep, err := d.writeShare(ctx, req, set, in, prev[in.Index])
+		if err != nil || share == nil {
+			d.failShareSet(ctx, set.SetId)
+			if err != nil {
+				return nil, len(shares), err
+			}
+			return &keylocker.StoreSharesRep{
+				Code: rep.Code,
+				Msg:  fmt.Sprintf("store share %d fail: %s", in.Index, rep.Msg),
+			}, len(shares), nil
+		}
+		shares = append(shares, share)
+	}
+	if err := repo.ActivateShareSet(ctx, req.WalletUuid, set.SetId, replaceSetId); err != nil {
+		d.failShareSet(ctx, set.SetId)
+		if errors.Is(err, model.ErrShareSetChanged) {
+			return &keylocker.StoreSharesRep{
+				Code: keylocker.ReturnCode_ERROR,
+				Msg:  "share set changed meanwhile, retry",
+			}, len(shares), nil
+		}
+		return nil, len(shares), fmt.Errorf("repo.ActivateShareSet fail, setId, %s, err: [%w]", set.SetId, err)
+	}
+	for _, s := range shares {
+		if s.Version > 1 {
+			d.pruneShare(ctx, req.ConsumerToken, s)
+		}
+	}
+	return &keylocker.StoreSharesRep{
+		Code:   keylocker.ReturnCode_SUCCESS,
+		Msg:    "store shares success",
+		SetId:  set.SetId,
+		Epoch:  set.Epoch,
+		Shares: shareMetas(shares),
+	}, len(shares), nil
+}
+
+// writeShare stores the share as the next version of the key holding the share of the previous set, it returns
+// a nil share with the rep of the backend when the backend refused it
+func (d *Dispatcher) writeShare(ctx context.Context, req *keylocker.StoreSharesReq, set *model.ShareSet, in *keylocker.ShareInput, prev *model.Share) (*model.Share, *keylocker.SetSocialKeyRep, error) {
+	adaptor, ok := d.acquire(in.Chain)
+	if !ok {
+		return nil, &keylocker.SetSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  config.UnsupportedOperation,
+		}, nil
+	}
+	defer adaptor.release()
+	keyReq := &keylocker.SetSocialKeyReq{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         in.Chain,
+		WalletUuid:    req.WalletUuid,
+		Key:           base64.StdEncoding.EncodeToString(in.Share),
+		Password:      req.Password,
+		SocialCode:    req.SocialCode,
+		Label:         fmt.Sprintf("mpc-share-%d", in.Index),
+	}
+	if prev != nil {
+		keyReq.KeyId = prev.KeyId
+	}
+	rep, err := adaptor.SetSocialKey(ctx, keyReq)
+	if err != nil {
+		return nil, nil, fmt.Errorf("SetSocialKey fail, chain, %s, share, %d, err: [%w]", in.Chain, in.Index, err)
+	}
+	if rep.Code != keylocker.ReturnCode_SUCCESS {
+		return nil, rep, nil
+	}
+	if rep.KeyId == "" {
+		return nil, &keylocker.SetSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  fmt.Sprintf("backend of %s does not return key ids", in.Chain),
+		}, nil
+	}
+	policy := in.Policy
+	if policy == "" {
+		policy = model.SharePolicyCredentials
+	}
+	share := &model.Share{
+		SetId:      set.SetId,
+		ShareIndex: in.Index,
+		KeyUuid:    req.WalletUuid,
+		Chain:      in.Chain,
+		KeyId:      rep.KeyId,
+		Version:    rep.Version,
+		Policy:     policy,
+	}
+	if err := d.repo().AddShare(ctx, share); err != nil {
+		return nil, nil, fmt.Errorf("repo.AddShare fail, setId, %s, share, %d, err: [%w]", set.SetId, in.Index, err)
+	}
+	return share, rep, nil
+}
+
+func (d *Dispatcher) failShareSet(ctx context.Context, setId string) {
+	if err := d.repo().UpdateShareSetStatus(ctx, setId, model.ShareSetStatusFailed); err != nil {
+		log.Error("mark share set failed fail", "setId", setId, "err", err)
+	}
+}
+
+// pruneShare removes the shares of the previous sets from the key, a failure only leaves them behind
+func (d *Dispatcher) pruneShare(ctx context.Context, consumerToken string, share *model.Share) {
+	adaptor, ok := d.acquire(share.Chain)
+	if !ok {
+		log.Error("prune old shares fail", "chain", share.Chain, "keyId", share.KeyId, "err", config.UnsupportedOperation)
+		return
+	}
+	defer adaptor.release()
+	rep, err := adaptor.PruneSocialKey(ctx, &keylocker.PruneSocialKeyReq{
+		ConsumerToken: consumerToken,
+		Chain:         share.Chain,
+		WalletUuid:    share.KeyUuid,
+		KeyId:         share.KeyId,
+		BeforeVersion: share.Version,
+	})
+	if err != nil {
+		log.Error("prune old shares fail", "chain", share.Chain, "keyId", share.KeyId, "err", err)
+		return
+	}
+	if rep.Code != keylocker.ReturnCode_SUCCESS {
+		log.Error("prune old shares fail", "chain", share.Chain, "keyId", share.KeyId, "msg", rep.Msg)
+	}
+}
+
+// GetShare returns the share of the active set, shares with the guardians policy also need a recovery case
+// approved by the guardians
+func (d *Dispatcher) GetShare(ctx context.Context, req *keylocker.GetShareReq) (*keylocker.GetShareRep, error) {
+	rsaObj, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode)
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.GetShareRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := d.checkFrozen(ctx, req.WalletUuid); err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.GetShareRep{
+				Code: code,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	if err := d.checkFactors(ctx, req.WalletUuid, factors{sessionToken: req.SessionToken, totpCode: req.TotpCode, recoveryCode: req.RecoveryCode}); err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.GetShareRep{
+				Code: code,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	repo := d.repo()
+	set, shares, err := d.activeShares(ctx, req.WalletUuid)
+	if err != nil {
+		return nil, err
+	}
+	var share *model.Share
+	for _, s := range shares {
+		if s.ShareIndex == req.Index {
+			share = s
+		}
+	}
+	if share == nil {
+		return &keylocker.GetShareRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  fmt.Sprintf("share %d not found", req.Index),
+		}, nil
+	}
+
+	if share.Policy == model.SharePolicyGuardians {
+		if _, _, err := repo.GetRecoveryPolicy(ctx, req.WalletUuid); errors.Is(err, gorm.ErrRecordNotFound) {
+			return &keylocker.GetShareRep{
+				Code: keylocker.ReturnCode_APPROVAL_REQUIRED,
+				Msg:  "wallet has no guardians to approve the share",
+			}, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("repo.GetRecoveryPolicy fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+		}
+	}
+	// the guardians and the recovery delay of the wallet apply to every share, like to its keys
+	rc, err := d.claimRecovery(ctx, req.WalletUuid, share.Chain, req.RecoveryCaseId)
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.GetShareRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	rep, err := d.readShare(ctx, rsaObj, set, share)
+	d.settleRecovery(ctx, rc, err == nil && rep.Code == keylocker.ReturnCode_SUCCESS)
+	return rep, err
+}
+
+func (d *Dispatcher) readShare(ctx context.Context, rsaObj *crypto.Rsa, set *model.ShareSet, share *model.Share) (*keylocker.GetShareRep, error) {
+	adaptor, ok := d.acquire(share.Chain)
+	if !ok {
+		return &keylocker.GetShareRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  config.UnsupportedOperation,
+		}, nil
+	}
+	defer adaptor.release()
+	rep, err := adaptor.GetSocialKey(ctx, &keylocker.GetSocialKeyReq{
+		Chain:      share.Chain,
+		WalletUuid: share.KeyUuid,
+		KeyId:      share.KeyId,
+		Version:    share.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rep.Code != keylocker.ReturnCode_SUCCESS || len(rep.KeyList) == 0 {
+		return &keylocker.GetShareRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  fmt.Sprintf("share %d not found on %s: %s", share.ShareIndex, share.Chain, rep.Msg),
+		}, nil
+	}
+	plain, err := rsaObj.Decrypt([]byte(rep.KeyList[0].Key))
+	if err != nil {
+		return &keylocker.GetShareRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  fmt.Sprintf("decrypt share %d fail: %v", share.ShareIndex, err),
+		}, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(string(plain))
+	if err != nil {
+		return &keylocker.GetShareRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  fmt.Sprintf("decode share %d fail: %v", share.ShareIndex, err),
+		}, nil
+	}
+	return &keylocker.GetShareRep{
+		Code:  keylocker.ReturnCode_SUCCESS,
+		Msg:   "get share success",
+		SetId: set.SetId,
+		Epoch: set.Epoch,
+		Index: share.ShareIndex,
+		Share: data,
+	}, nil
+}
+
+func (d *Dispatcher) ListShares(ctx context.Context, req *keylocker.ListSharesReq) (*keylocker.ListSharesRep, error) {
+	set, shares, err := d.activeShares(ctx, req.WalletUuid)
+	if err != nil {
+		return nil, err
+	}
+	if set == nil {
+		return &keylocker.ListSharesRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  "wallet has no share set",
+		}, nil
+	}
+	return &keylocker.ListSharesRep{
+		Code:   keylocker.ReturnCode_SUCCESS,
+		Msg:    "list shares success",
+		SetId:  set.SetId,
+		Epoch:  set.Epoch,
+		Shares: shareMetas(shares),
+	}, nil
+}
+
+// activeShares returns the active set of the wallet and its shares, a nil set when the wallet has none
+func (d *Dispatcher) activeShares(ctx context.Context, walletUuid string) (*model.ShareSet, []*model.Share, error) {
+	repo := d.repo()
+	set, err := repo.GetActiveShareSet(ctx, walletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("repo.GetActiveShareSet fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	shares, err := repo.ListShares(ctx, set.SetId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("repo.ListShares fail, setId, %s, err: [%w]", set.SetId, err)
+	}
+	return set, shares, nil
+}
+
+// dropShares removes the keys holding shares from the keys of the wallet, shares are only released by getShare
+func (d *Dispatcher) dropShares(ctx context.Context, walletUuid string, keys []*keylocker.SocialKey) ([]*keylocker.SocialKey, error) {
+	ids, err := d.repo().ListShareKeyIds(ctx, walletUuid)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListShareKeyIds fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	if len(ids) == 0 {
+		return keys, nil
+	}
+	shareKeys := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		shareKeys[id] = true
+	}
+	res := make([]*keylocker.SocialKey, 0, len(keys))
+	for _, k := range keys {
+		if !shareKeys[k.Id] {
+			res = append(res, k)
+		}
+	}
+	return res, nil
+}
+
+// isShareKey reports whether the key holds shares of the wallet
+func (d *Dispatcher) isShareKey(ctx context.Context, walletUuid, keyId string) (bool, error) {
+	keys, err := d.dropShares(ctx, walletUuid, []*keylocker.SocialKey{{Id: keyId}})
+	if err != nil {
+		return false, err
+	}
+	return len(keys) == 0, nil
+}
+
+func checkShares(shares []*keylocker.ShareInput) error {
+	if len(shares) == 0 {
+		return errors.New("shares is empty")
+	}
+	seen := make(map[uint32]bool, len(shares))
+	for _, s := range shares {
+		if seen[s.Index] {
+			return fmt.Errorf("duplicate share index %d", s.Index)
+		}
+		seen[s.Index] = true
+		if len(s.Share) == 0 {
+			return fmt.Errorf("share %d is empty", s.Index)
+		}
+		switch s.Policy {
+		case "", model.SharePolicyCredentials, model.SharePolicyGuardians:
+		default:
+			return fmt.Errorf("unknown policy %q of share %d", s.Policy, s.Index)
+		}
+	}
+	return nil
+}
+
+// sameShareLayout checks that a refresh keeps every share index on its chain, so that the old shares
+// are pruned from the keys holding them
+func sameShareLayout(prev map[uint32]*model.Share, shares []*keylocker.ShareInput) error {
+	if len(prev) != len(shares) {
+		return fmt.Errorf("refresh must keep the %d shares of the set", len(prev))
+	}
+	for _, s := range shares {
+		p, ok := prev[s.Index]
+		if !ok {
+			return fmt.Errorf("share %d is not in the set", s.Index)
+		}
+		if p.Chain != s.Chain {
+			return fmt.Errorf("share %d must stay on %s", s.Index, p.Chain)
+		}
+	}
+	return nil
+}
+
+func shareMetas(shares []*model.Share) []*keylocker.ShareMeta {
+	res := make([]*keylocker.ShareMeta, 0, len(shares))
+	for _, s := range shares {
+		res = append(res, &keylocker.ShareMeta{
+			Index:   s.ShareIndex,
+			Chain:   s.Chain,
+			Policy:  s.Policy,
+			KeyId:   s.KeyId,
+			Version: s.Version,
+		})
+	}
+	return res
+}
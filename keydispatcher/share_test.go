@@ -0,0 +1,133 @@
+package keydispatcher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+func TestRefreshSharesPrunesOldShares(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	ctx := context.Background()
+	stored, err := d.StoreShares(ctx, &keylocker.StoreSharesReq{
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+		Shares: []*keylocker.ShareInput{
+			{Index: 1, Chain: testChain, Share: []byte("old share one")},
+			{Index: 2, Chain: testChain, Share: []byte("old share two")},
+		},
+	})
+	if err != nil || stored.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("store shares fail: %v %v", stored, err)
+	}
+
+	refresh := &keylocker.RefreshSharesReq{
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+		Shares: []*keylocker.ShareInput{
+			{Index: 1, Chain: testChain, Share: []byte("new share one")},
+			{Index: 2, Chain: testChain, Share: []byte("new share two")},
+		},
+		SetId: stored.SetId,
+	}
+	refreshed, err := d.RefreshShares(ctx, refresh)
+	if err != nil || refreshed.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("refresh shares fail: %v %v", refreshed, err)
+	}
+	if refreshed.Epoch != stored.Epoch+1 {
+		t.Fatalf("epoch is %d after the refresh, want %d", refreshed.Epoch, stored.Epoch+1)
+	}
+	// a refresh of the replaced set is refused
+	if stale, err := d.RefreshShares(ctx, refresh); err != nil || stale.Code != keylocker.ReturnCode_ERROR {
+		t.Fatalf("refresh of a stale set got %v %v, want ERROR", stale, err)
+	}
+
+	share, err := d.GetShare(ctx, &keylocker.GetShareReq{
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+		Index:      2,
+	})
+	if err != nil || share.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("get share fail: %v %v", share, err)
+	}
+	if string(share.Share) != "new share two" || share.SetId != refreshed.SetId {
+		t.Fatalf("got share %q of set %s, want the refreshed one", share.Share, share.SetId)
+	}
+	for _, s := range refreshed.Shares {
+		versions, err := d.repo().ListKeyVersions(ctx, "wallet", testChain, s.KeyId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(versions) != 1 || versions[0].Version != s.Version {
+			t.Fatalf("share %d keeps %d versions, want only version %d", s.Index, len(versions), s.Version)
+		}
+	}
+}
+
+func TestGetShareHonoursRecoveryDelay(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	ctx := context.Background()
+	if rep, err := d.StoreShares(ctx, &keylocker.StoreSharesReq{
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+		Shares:     []*keylocker.ShareInput{{Index: 1, Chain: testChain, Share: []byte("share one")}},
+	}); err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("store shares fail: %v %v", rep, err)
+	}
+	if rep, err := d.SetRecoveryDelay(ctx, &keylocker.SetRecoveryDelayReq{
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+		Delay:      3600,
+	}); err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("set recovery delay fail: %v %v", rep, err)
+	}
+
+	share, err := d.GetShare(ctx, &keylocker.GetShareReq{
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+		Index:      1,
+	})
+	if err != nil || share.Code != keylocker.ReturnCode_APPROVAL_REQUIRED {
+		t.Fatalf("get a credentials share got %v %v, want APPROVAL_REQUIRED", share, err)
+	}
+}
+
+func TestRefreshSharesRefusedWhileFrozen(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	ctx := context.Background()
+	shares := []*keylocker.ShareInput{{Index: 1, Chain: testChain, Share: []byte("share one")}}
+	stored, err := d.StoreShares(ctx, &keylocker.StoreSharesReq{
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+		Shares:     shares,
+	})
+	if err != nil || stored.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("store shares fail: %v %v", stored, err)
+	}
+	if err := d.repo().FreezeWallet(ctx, "wallet", "stolen phone"); err != nil {
+		t.Fatal(err)
+	}
+
+	refreshed, err := d.RefreshShares(ctx, &keylocker.RefreshSharesReq{
+		WalletUuid: "wallet",
+		Password:   sealed(t, testPassword),
+		SocialCode: sealed(t, testSocialCode),
+		Shares:     []*keylocker.ShareInput{{Index: 1, Chain: testChain, Share: []byte("new share one")}},
+		SetId:      stored.SetId,
+	})
+	if err != nil || refreshed.Code != keylocker.ReturnCode_FROZEN {
+		t.Fatalf("refresh got %v %v, want FROZEN", refreshed, err)
+	}
+	versions, err := d.repo().ListKeyVersions(ctx, "wallet", testChain, stored.Shares[0].KeyId)
+	if err != nil || len(versions) != 1 || versions[0].Version != stored.Shares[0].Version {
+		t.Fatalf("the frozen share key has versions %v, err %v, want only the stored one", versions, err)
+	}
+}
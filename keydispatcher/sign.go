@@ -0,0 +1,317 @@
+package keydispatcher
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+const (
+	SignKindTransaction = "transaction"
+	SignKindMessage     = "message"
+	SignKindTypedData   = "typed_data"
+)
+
+// SignRequest is what a sign policy decides on. To is the recipient of a transaction or the verifying
+// contract of typed data, nil for messages. ChainId and Value are nil when the payload has none.
+type SignRequest struct {
+	WalletUuid string
+	Chain      string
+	KeyId      string
+	Kind       string
+	ChainId    *big.Int
+	To         *common.Address
+	Value      *big.Int
+	Hash       common.Hash
+}
+
+// SignPolicy rejects a signature by returning an error, its message is returned to the client
+type SignPolicy func(ctx context.Context, req *SignRequest) error
+
+var (
+	signPolicyMu sync.RWMutex
+	signPolicies []SignPolicy
+)
+
+// RegisterSignPolicy adds a policy every signature has to pass after the allowed_to of the config,
+// it is meant to be called before New, usually from an init function
+func RegisterSignPolicy(policy SignPolicy) error {
+	if policy == nil {
+		return errors.New("sign policy is nil")
+	}
+	signPolicyMu.Lock()
+	defer signPolicyMu.Unlock()
+	signPolicies = append(signPolicies, policy)
+	return nil
+}
+
+// signTarget is the parsed payload, tx is only set for transactions
+type signTarget struct {
+	req    SignRequest
+	tx     *types.Transaction
+	signer types.Signer
+}
+
+// SignWithSocialKey signs the payload with the stored key of the wallet and returns the signature only,
+// the key is decrypted in memory and wiped once signed
+func (d *Dispatcher) SignWithSocialKey(ctx context.Context, req *keylocker.SignWithSocialKeyReq) (*keylocker.SignWithSocialKeyRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.SignWithSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	target, err := parseSignPayload(req.Kind, req.Payload, req.ChainId)
+	if err != nil {
+		return &keylocker.SignWithSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, nil
+	}
+	target.req.WalletUuid, target.req.Chain, target.req.KeyId = req.WalletUuid, req.Chain, req.KeyId
+	if err := d.checkSignPolicy(ctx, &target.req); err != nil {
+		return &keylocker.SignWithSocialKeyRep{
+			Code: keylocker.ReturnCode_POLICY_DENIED,
+			Msg:  err.Error(),
+		}, nil
+	}
+	rsaObj, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode)
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.SignWithSocialKeyRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := d.checkFactors(ctx, req.WalletUuid, factors{sessionToken: req.SessionToken, totpCode: req.TotpCode, recoveryCode: req.RecoveryCode}); err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.SignWithSocialKeyRep{
+				Code: code,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	adaptor, ok := d.acquire(req.Chain)
+	if !ok {
+		return &keylocker.SignWithSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  config.UnsupportedOperation,
+		}, nil
+	}
+	defer adaptor.release()
+	rc, err := d.claimRecovery(ctx, req.WalletUuid, req.Chain, req.RecoveryCaseId)
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.SignWithSocialKeyRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	keyRep, err := d.getSocialKey(ctx, adaptor, &keylocker.GetSocialKeyReq{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		WalletUuid:    req.WalletUuid,
+		KeyId:         req.KeyId,
+		Version:       req.Version,
+	})
+	var rep *keylocker.SignWithSocialKeyRep
+	switch {
+	case err != nil:
+	case keyRep.Code != keylocker.ReturnCode_SUCCESS:
+		rep = &keylocker.SignWithSocialKeyRep{Code: keyRep.Code, Msg: keyRep.Msg}
+	case len(keyRep.KeyList) == 0:
+		rep = &keylocker.SignWithSocialKeyRep{Code: keylocker.ReturnCode_NOT_FOUND, Msg: "no social key stored"}
+	case len(keyRep.KeyList) > 1:
+		rep = &keylocker.SignWithSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  fmt.Sprintf("key_id is required, the wallet has %d keys", len(keyRep.KeyList)),
+		}
+	default:
+		target.req.KeyId = keyRep.KeyList[0].Id
+		plain, decErr := rsaObj.Decrypt([]byte(keyRep.KeyList[0].Key))
+		if decErr != nil {
+			rep = &keylocker.SignWithSocialKeyRep{
+				Code: keylocker.ReturnCode_ERROR,
+				Msg:  fmt.Sprintf("decrypt key fail: %v", decErr),
+			}
+			break
+		}
+		rep, err = d.sign(ctx, target, plain)
+	}
+	d.settleRecovery(ctx, rc, err == nil && rep.Code == keylocker.ReturnCode_SUCCESS)
+	return rep, err
+}
+
+// sign signs the target with the hex encoded secp256k1 key, the key is wiped before returning
+func (d *Dispatcher) sign(ctx context.Context, target *signTarget, plain []byte) (*keylocker.SignWithSocialKeyRep, error) {
+	defer wipe(plain)
+	raw := make([]byte, hex.DecodedLen(len(plain)))
+	defer wipe(raw)
+	n, err := hex.Decode(raw, bytes.TrimPrefix(bytes.TrimSpace(plain), []byte("0x")))
+	if err != nil {
+		return &keylocker.SignWithSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "stored key is not a hex encoded secp256k1 key",
+		}, nil
+	}
+	key, err := ethcrypto.ToECDSA(raw[:n])
+	if err != nil {
+		return &keylocker.SignWithSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  fmt.Sprintf("stored key is not a secp256k1 key: %v", err),
+		}, nil
+	}
+	defer wipeKey(key)
+	address := ethcrypto.PubkeyToAddress(key.PublicKey)
+
+	sig, err := ethcrypto.Sign(target.req.Hash.Bytes(), key)
+	if err != nil {
+		return nil, fmt.Errorf("sign fail, err: [%w]", err)
+	}
+	rep := &keylocker.SignWithSocialKeyRep{
+		Code:    keylocker.ReturnCode_SUCCESS,
+		Msg:     "sign success",
+		Address: address.Hex(),
+		Hash:    target.req.Hash.Hex(),
+	}
+	if target.tx != nil {
+		signed, err := target.tx.WithSignature(target.signer, sig)
+		if err != nil {
+			return nil, fmt.Errorf("tx.WithSignature fail, err: [%w]", err)
+		}
+		if rep.SignedTx, err = signed.MarshalBinary(); err != nil {
+			return nil, fmt.Errorf("tx.MarshalBinary fail, err: [%w]", err)
+		}
+	} else {
+		sig[64] += 27
+	}
+	rep.Signature = sig
+
+	detail := map[string]interface{}{
+		"kind":    target.req.Kind,
+		"hash":    rep.Hash,
+		"address": rep.Address,
+	}
+	if target.req.To != nil {
+		detail["to"] = target.req.To.Hex()
+	}
+	if err := d.audit(ctx, &model.AuditRecord{
+		Action:  model.AuditActionSign,
+		KeyUuid: target.req.WalletUuid,
+		Chain:   target.req.Chain,
+	}, detail); err != nil {
+		return nil, fmt.Errorf("audit fail, err: [%w]", err)
+	}
+	return rep, nil
+}
+
+// checkSignPolicy applies the allowed_to of the config, then the registered policies
+func (d *Dispatcher) checkSignPolicy(ctx context.Context, req *SignRequest) error {
+	if allowed := d.config().Sign.AllowedTo; len(allowed) > 0 && req.Kind != SignKindMessage {
+		if req.To == nil {
+			return errors.New("destination is required by the sign policy")
+		}
+		ok := false
+		for _, a := range allowed {
+			if common.HexToAddress(a) == *req.To {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return fmt.Errorf("destination %s is not allowed", req.To.Hex())
+		}
+	}
+	signPolicyMu.RLock()
+	policies := signPolicies
+	signPolicyMu.RUnlock()
+	for _, policy := range policies {
+		if err := policy(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseSignPayload(kind string, payload []byte, chainId uint64) (*signTarget, error) {
+	if len(payload) == 0 {
+		return nil, errors.New("payload is empty")
+	}
+	target := &signTarget{req: SignRequest{Kind: kind}}
+	switch kind {
+	case SignKindTransaction:
+		if chainId == 0 {
+			return nil, errors.New("chain_id is required to sign a transaction")
+		}
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(payload); err != nil {
+			return nil, fmt.Errorf("invalid transaction: %v", err)
+		}
+		cid := new(big.Int).SetUint64(chainId)
+		if tx.Type() != types.LegacyTxType && tx.ChainId().Cmp(cid) != 0 {
+			return nil, fmt.Errorf("transaction is for chain %s, not %d", tx.ChainId(), chainId)
+		}
+		target.tx, target.signer = tx, types.LatestSignerForChainID(cid)
+		target.req.ChainId, target.req.To, target.req.Value = cid, tx.To(), tx.Value()
+		target.req.Hash = target.signer.Hash(tx)
+	case SignKindMessage:
+		target.req.Hash = common.BytesToHash(accounts.TextHash(payload))
+	case SignKindTypedData:
+		var typedData apitypes.TypedData
+		if err := json.Unmarshal(payload, &typedData); err != nil {
+			return nil, fmt.Errorf("invalid typed data: %v", err)
+		}
+		hash, _, err := apitypes.TypedDataAndHash(typedData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid typed data: %v", err)
+		}
+		target.req.Hash = common.BytesToHash(hash)
+		if typedData.Domain.ChainId != nil {
+			target.req.ChainId = (*big.Int)(typedData.Domain.ChainId)
+		}
+		if c := typedData.Domain.VerifyingContract; c != "" {
+			if !common.IsHexAddress(c) {
+				return nil, fmt.Errorf("invalid verifyingContract %s", c)
+			}
+			to := common.HexToAddress(c)
+			target.req.To = &to
+		}
+	default:
+		return nil, fmt.Errorf("unknown kind %q, expect %s", kind, strings.Join([]string{SignKindTransaction, SignKindMessage, SignKindTypedData}, ", "))
+	}
+	return target, nil
+}
+
+func wipeKey(key *ecdsa.PrivateKey) {
+	words := key.D.Bits()
+	for i := range words {
+		words[i] = 0
+	}
+	key.D.SetUint64(0)
+}
+
+func wipe(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -0,0 +1,189 @@
+package keydispatcher
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/crypto"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+const (
+	defaultSocialCodeLength      = 8
+	defaultSocialCodeTTL         = 24 * time.Hour
+	defaultSocialCodeMaxAttempts = 5
+	maxChannelLength             = 32
+)
+
+// IssueSocialCode issues a social code bound to the wallet and the delivery channel
+func (d *Dispatcher) IssueSocialCode(ctx context.Context, req *keylocker.IssueSocialCodeReq) (*keylocker.IssueSocialCodeRep, error) {
+	if !d.isAdmin(req.ConsumerToken) {
+		return &keylocker.IssueSocialCodeRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  config.PermissionDenied,
+		}, nil
+	}
+	if req.WalletUuid == "" || req.Channel == "" {
+		return &keylocker.IssueSocialCodeRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid and channel are required",
+		}, nil
+	}
+	if len(req.Channel) > maxChannelLength {
+		return &keylocker.IssueSocialCodeRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  fmt.Sprintf("channel must be at most %d characters", maxChannelLength),
+		}, nil
+	}
+	conf := d.config().SocialCode
+	length, ttl, maxAttempts := conf.Length, time.Duration(conf.TTL)*time.Second, conf.MaxAttempts
+	if length <= 0 {
+		length = defaultSocialCodeLength
+	}
+	if req.Ttl > 0 {
+		ttl = time.Duration(req.Ttl) * time.Second
+	} else if ttl <= 0 {
+		ttl = defaultSocialCodeTTL
+	}
+	if maxAttempts == 0 {
+		maxAttempts = defaultSocialCodeMaxAttempts
+	}
+
+	var id [32]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, fmt.Errorf("generate code id fail, err: [%w]", err)
+	}
+	code := make([]byte, length)
+	if _, err := rand.Read(code); err != nil {
+		return nil, fmt.Errorf("generate social code fail, err: [%w]", err)
+	}
+	for i, b := range code {
+		code[i] = codeAlphabet[int(b)%len(codeAlphabet)]
+	}
+	issued := &model.IssuedSocialCode{
+		CodeId:      hexutil.Encode(id[:]),
+		KeyUuid:     req.WalletUuid,
+		Channel:     req.Channel,
+		CodeHash:    hashOneTimeCode(req.WalletUuid, string(code)),
+		Reusable:    req.Reusable,
+		ExpiresAt:   time.Now().Add(ttl),
+		MaxAttempts: maxAttempts,
+	}
+	if err := d.repo().CreateIssuedSocialCode(ctx, issued); err != nil {
+		return nil, fmt.Errorf("repo.CreateIssuedSocialCode fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	return &keylocker.IssueSocialCodeRep{
+		Code:       keylocker.ReturnCode_SUCCESS,
+		Msg:        "issue social code success",
+		CodeId:     issued.CodeId,
+		SocialCode: string(code),
+		ExpiresAt:  issued.ExpiresAt.Unix(),
+	}, nil
+}
+
+// RevokeSocialCode revokes one or every social code issued for the wallet
+func (d *Dispatcher) RevokeSocialCode(ctx context.Context, req *keylocker.RevokeSocialCodeReq) (*keylocker.RevokeSocialCodeRep, error) {
+	if !d.isAdmin(req.ConsumerToken) {
+		return &keylocker.RevokeSocialCodeRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  config.PermissionDenied,
+		}, nil
+	}
+	if req.WalletUuid == "" {
+		return &keylocker.RevokeSocialCodeRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	revoked, err := d.repo().RevokeIssuedSocialCodes(ctx, req.WalletUuid, req.CodeId, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("repo.RevokeIssuedSocialCodes fail, req, %v, err: [%w]", req, err)
+	}
+	if revoked == 0 {
+		return &keylocker.RevokeSocialCodeRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  "no social code to revoke",
+		}, nil
+	}
+	return &keylocker.RevokeSocialCodeRep{
+		Code:    keylocker.ReturnCode_SUCCESS,
+		Msg:     "revoke social code success",
+		Revoked: uint32(revoked),
+	}, nil
+}
+
+// claimSocialCode checks the social code of a key to store against the codes issued for the wallet and
+// spends it when it is single use. It returns nil for a wallet without issued codes, unless they are required.
+func (d *Dispatcher) claimSocialCode(ctx context.Context, walletUuid, socialCode string) (*model.IssuedSocialCode, error) {
+	repo := d.repo()
+	codes, err := repo.ListIssuedSocialCodes(ctx, walletUuid)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListIssuedSocialCodes fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	if len(codes) == 0 {
+		if d.config().SocialCode.Required {
+			return nil, fmt.Errorf("%w: no social code was issued for the wallet", errInvalidCredentials)
+		}
+		return nil, nil
+	}
+	plain, err := crypto.AesDecrypt([]byte(socialCode), []byte(d.config().AesKey))
+	if err != nil {
+		return nil, errInvalidCredentials
+	}
+	hash := hashOneTimeCode(walletUuid, string(plain))
+	wipe(plain)
+
+	now := time.Now()
+	for _, c := range codes {
+		if subtle.ConstantTimeCompare([]byte(c.CodeHash), []byte(hash)) != 1 {
+			continue
+		}
+		if !c.Usable(now) {
+			return nil, fmt.Errorf("%w: social code is revoked, expired, used or blocked by wrong attempts", errInvalidCredentials)
+		}
+		if c.Reusable {
+			return c, nil
+		}
+		ok, err := repo.UseIssuedSocialCode(ctx, c.ID, now)
+		if err != nil {
+			return nil, fmt.Errorf("repo.UseIssuedSocialCode fail, codeId, %s, err: [%w]", c.CodeId, err)
+		}
+		if !ok {
+			return nil, fmt.Errorf("%w: social code already used", errInvalidCredentials)
+		}
+		return c, nil
+	}
+	if err := repo.AddSocialCodeAttempt(ctx, walletUuid, now); err != nil {
+		return nil, fmt.Errorf("repo.AddSocialCodeAttempt fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	return nil, fmt.Errorf("%w: social code was not issued for the wallet", errInvalidCredentials)
+}
+
+// withSocialCode claims the social code for the wallet, runs store and settles the code with whether store
+// stored a key. Every path creating a wallet or storing a key goes through it, so that the issued codes hold.
+func (d *Dispatcher) withSocialCode(ctx context.Context, walletUuid, socialCode string, store func() (bool, error)) error {
+	issued, err := d.claimSocialCode(ctx, walletUuid, socialCode)
+	if err != nil {
+		return err
+	}
+	stored, err := store()
+	d.settleSocialCode(ctx, issued, stored)
+	return err
+}
+
+// settleSocialCode gives back the single use code when no key was stored with it
+func (d *Dispatcher) settleSocialCode(ctx context.Context, c *model.IssuedSocialCode, stored bool) {
+	if c == nil || c.Reusable || stored {
+		return
+	}
+	if err := d.repo().ReleaseIssuedSocialCode(ctx, c.ID); err != nil {
+		log.Error("release social code fail", "codeId", c.CodeId, "err", err)
+	}
+}
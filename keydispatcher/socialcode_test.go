@@ -0,0 +1,78 @@
+package keydispatcher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+func TestIssuedSocialCodeGuardsEveryStore(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	d.conf.SocialCode.Required = true
+	ctx := context.Background()
+	issue := func(walletUuid string, reusable bool) string {
+		t.Helper()
+		rep, err := d.IssueSocialCode(ctx, &keylocker.IssueSocialCodeReq{ConsumerToken: testAdminToken, WalletUuid: walletUuid, Channel: "sms", Reusable: reusable})
+		if err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+			t.Fatalf("issue social code fail: %v %v", rep, err)
+		}
+		return rep.SocialCode
+	}
+	// the issued codes have 8 characters, the password 8 more make the aes key of the memory adaptor
+	password := "Zq8#vLm2"
+
+	shares, err := d.StoreShares(ctx, &keylocker.StoreSharesReq{
+		WalletUuid: "wallet",
+		Password:   sealed(t, password),
+		SocialCode: sealed(t, "48291375"),
+		Shares:     []*keylocker.ShareInput{{Index: 1, Chain: testChain, Share: []byte("share one")}},
+	})
+	if err != nil || shares.Code != keylocker.ReturnCode_INVALID_CREDENTIALS {
+		t.Fatalf("store shares with a code not issued got %v %v, want INVALID_CREDENTIALS", shares, err)
+	}
+	// the wallet keeps its social code, a reusable one stores its keys and shares
+	code := issue("wallet", true)
+	if rep, err := d.SetSocialKey(ctx, &keylocker.SetSocialKeyReq{
+		Chain:      testChain,
+		WalletUuid: "wallet",
+		Key:        "seed phrase",
+		Password:   sealed(t, password),
+		SocialCode: sealed(t, code),
+	}); err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("store key with the issued code fail: %v %v", rep, err)
+	}
+	shares, err = d.StoreShares(ctx, &keylocker.StoreSharesReq{
+		WalletUuid: "wallet",
+		Password:   sealed(t, password),
+		SocialCode: sealed(t, code),
+		Shares: []*keylocker.ShareInput{
+			{Index: 1, Chain: testChain, Share: []byte("share one")},
+			{Index: 2, Chain: testChain, Share: []byte("share two")},
+		},
+	})
+	if err != nil || shares.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("store shares with the issued code fail: %v %v", shares, err)
+	}
+
+	transfer := &keylocker.TransferSocialKeyReq{
+		Chain:         testChain,
+		WalletUuid:    "wallet",
+		Password:      sealed(t, password),
+		SocialCode:    sealed(t, code),
+		NewWalletUuid: "heir",
+		NewPassword:   sealed(t, "Nw5!tRq9"),
+		NewSocialCode: sealed(t, "73104628"),
+	}
+	rep, err := d.TransferSocialKey(ctx, transfer)
+	if err != nil || rep.Code != keylocker.ReturnCode_INVALID_CREDENTIALS {
+		t.Fatalf("transfer to a code not issued got %v %v, want INVALID_CREDENTIALS", rep, err)
+	}
+	if n, err := d.repo().CountKeysByUID(ctx, "heir"); err != nil || n != 0 {
+		t.Fatalf("%d keys written with a code not issued, err %v", n, err)
+	}
+	transfer.NewSocialCode = sealed(t, issue("heir", false))
+	if rep, err := d.TransferSocialKey(ctx, transfer); err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("transfer with the issued code fail: %v %v", rep, err)
+	}
+}
@@ -0,0 +1,293 @@
+package keydispatcher
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/savour-labs/key-locker/crypto"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/notify"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"gorm.io/gorm"
+)
+
+const (
+	totpDigits       = 6
+	totpSecretSize   = 20
+	oneTimeCodeCount = 10
+	// codeAlphabet is the Crockford base32 alphabet, which leaves out the letters read like digits
+	codeAlphabet = "0123456789abcdefghjkmnpqrstvwxyz"
+	codeLength   = 10
+)
+
+var (
+	errTotpRequired = errors.New("totp code is required")
+	errInvalidTotp  = errors.New("invalid totp code")
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// EnrollTotp generates a new totp secret for the wallet, enforced once a code of it is confirmed
+func (d *Dispatcher) EnrollTotp(ctx context.Context, req *keylocker.EnrollTotpReq) (*keylocker.EnrollTotpRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.EnrollTotpRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	if _, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode); err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.EnrollTotpRep{
+				Code: code,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	repo := d.repo()
+	current, err := repo.GetTotpSecret(ctx, req.WalletUuid)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("repo.GetTotpSecret fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	if current != nil && current.Enabled {
+		return &keylocker.EnrollTotpRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "totp is enabled, disable it first",
+		}, nil
+	}
+
+	secret := make([]byte, totpSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("generate totp secret fail, err: [%w]", err)
+	}
+	encrypted, err := crypto.AesEncrypt(secret, []byte(d.config().AesKey))
+	if err != nil {
+		return nil, fmt.Errorf("encrypt totp secret fail, err: [%w]", err)
+	}
+	if err := repo.SaveTotpSecret(ctx, req.WalletUuid, base64.StdEncoding.EncodeToString(encrypted)); err != nil {
+		return nil, fmt.Errorf("repo.SaveTotpSecret fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	issuer := d.config().Totp.Issuer
+	if issuer == "" {
+		issuer = "KeyLocker"
+	}
+	query := url.Values{}
+	query.Set("secret", totpEncoding.EncodeToString(secret))
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(int(crypto.TotpPeriod/time.Second)))
+	uri := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + req.WalletUuid,
+		RawQuery: query.Encode(),
+	}
+	return &keylocker.EnrollTotpRep{
+		Code:   keylocker.ReturnCode_SUCCESS,
+		Msg:    "confirm the enrollment with a code of the secret",
+		Secret: totpEncoding.EncodeToString(secret),
+		Uri:    uri.String(),
+	}, nil
+}
+
+// ConfirmTotp enables the totp secret of the wallet with its first code and returns the backup codes
+func (d *Dispatcher) ConfirmTotp(ctx context.Context, req *keylocker.ConfirmTotpReq) (*keylocker.ConfirmTotpRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.ConfirmTotpRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	if _, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode); err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.ConfirmTotpRep{
+				Code: code,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	repo := d.repo()
+	totp, err := repo.GetTotpSecret(ctx, req.WalletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &keylocker.ConfirmTotpRep{
+			Code: keylocker.ReturnCode_NOT_FOUND,
+			Msg:  "no totp enrollment, see enrollTotp",
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetTotpSecret fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	if totp.Enabled {
+		return &keylocker.ConfirmTotpRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "totp is already enabled",
+		}, nil
+	}
+	if err := d.useTotpCode(ctx, totp, normalizeCode(req.TotpCode)); err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.ConfirmTotpRep{
+				Code: code,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	codes, err := d.issueOneTimeCodes(ctx, req.WalletUuid, model.CodeKindTotpBackup)
+	if err != nil {
+		return nil, err
+	}
+	d.notify(&notify.Event{Type: notify.EventTotpEnabled, WalletUuid: req.WalletUuid})
+	return &keylocker.ConfirmTotpRep{
+		Code:        keylocker.ReturnCode_SUCCESS,
+		Msg:         "totp enabled",
+		BackupCodes: codes,
+	}, nil
+}
+
+// DisableTotp removes the totp secret and the backup codes of the wallet, proven by a code of either
+func (d *Dispatcher) DisableTotp(ctx context.Context, req *keylocker.DisableTotpReq) (*keylocker.DisableTotpRep, error) {
+	if req.WalletUuid == "" {
+		return &keylocker.DisableTotpRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "wallet_uuid is required",
+		}, nil
+	}
+	_, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode)
+	if err == nil {
+		err = d.checkTotp(ctx, req.WalletUuid, req.TotpCode)
+	}
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.DisableTotpRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := d.repo().DeleteTotpSecret(ctx, req.WalletUuid); err != nil {
+		return nil, fmt.Errorf("repo.DeleteTotpSecret fail, walletUuid, %s, err: [%w]", req.WalletUuid, err)
+	}
+	d.notify(&notify.Event{Type: notify.EventTotpDisabled, WalletUuid: req.WalletUuid})
+	return &keylocker.DisableTotpRep{
+		Code: keylocker.ReturnCode_SUCCESS,
+		Msg:  "totp disabled",
+	}, nil
+}
+
+// checkTotp requires a code of the enabled totp secret of the wallet, or one of its backup codes.
+// Wrong codes count towards the lock of the wallet like wrong credentials.
+func (d *Dispatcher) checkTotp(ctx context.Context, walletUuid, code string) error {
+	repo := d.repo()
+	totp, err := repo.GetTotpSecret(ctx, walletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("repo.GetTotpSecret fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	if !totp.Enabled {
+		return nil
+	}
+	if code == "" {
+		return errTotpRequired
+	}
+	normalized := normalizeCode(code)
+	if len(normalized) != totpDigits {
+		ok, err := repo.UseOneTimeCode(ctx, walletUuid, model.CodeKindTotpBackup, hashOneTimeCode(walletUuid, normalized), time.Now())
+		if err != nil {
+			return fmt.Errorf("repo.UseOneTimeCode fail, walletUuid, %s, err: [%w]", walletUuid, err)
+		}
+		if !ok {
+			if err := d.countFailure(ctx, walletUuid, time.Now()); err != nil {
+				return err
+			}
+			return fmt.Errorf("%w: unknown or used backup code", errInvalidTotp)
+		}
+		return nil
+	}
+	return d.useTotpCode(ctx, totp, normalized)
+}
+
+// useTotpCode accepts a code within the skew of the clock, of a later time step than the last code accepted
+func (d *Dispatcher) useTotpCode(ctx context.Context, totp *model.TotpSecret, code string) error {
+	encrypted, err := base64.StdEncoding.DecodeString(totp.Secret)
+	if err != nil {
+		return fmt.Errorf("decode totp secret fail, walletUuid, %s, err: [%w]", totp.KeyUuid, err)
+	}
+	secret, err := crypto.AesDecrypt(encrypted, []byte(d.config().AesKey))
+	if err != nil {
+		return fmt.Errorf("decrypt totp secret fail, walletUuid, %s, err: [%w]", totp.KeyUuid, err)
+	}
+	defer wipe(secret)
+	skew := d.config().Totp.Skew
+	if skew <= 0 {
+		skew = 1
+	}
+	now := time.Now()
+	current := crypto.TotpCounter(now)
+	var matched uint64
+	for i := -skew; i <= skew; i++ {
+		counter := current + uint64(i)
+		if subtle.ConstantTimeCompare([]byte(crypto.HOTP(secret, counter, totpDigits)), []byte(code)) == 1 {
+			matched = counter
+		}
+	}
+	if matched == 0 {
+		if err := d.countFailure(ctx, totp.KeyUuid, now); err != nil {
+			return err
+		}
+		return errInvalidTotp
+	}
+	ok, err := d.repo().UseTotpCounter(ctx, totp.KeyUuid, matched)
+	if err != nil {
+		return fmt.Errorf("repo.UseTotpCounter fail, walletUuid, %s, err: [%w]", totp.KeyUuid, err)
+	}
+	if !ok {
+		return fmt.Errorf("%w: code already used, wait for the next one", errInvalidTotp)
+	}
+	return nil
+}
+
+// issueOneTimeCodes replaces the codes of the kind of the wallet, only their hashes are stored
+func (d *Dispatcher) issueOneTimeCodes(ctx context.Context, walletUuid, kind string) ([]string, error) {
+	codes := make([]string, 0, oneTimeCodeCount)
+	hashes := make([]string, 0, oneTimeCodeCount)
+	for i := 0; i < oneTimeCodeCount; i++ {
+		raw := make([]byte, codeLength)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, fmt.Errorf("generate code fail, err: [%w]", err)
+		}
+		for j, b := range raw {
+			raw[j] = codeAlphabet[int(b)%len(codeAlphabet)]
+		}
+		hashes = append(hashes, hashOneTimeCode(walletUuid, string(raw)))
+		codes = append(codes, string(raw[:codeLength/2])+"-"+string(raw[codeLength/2:]))
+	}
+	if err := d.repo().ReplaceOneTimeCodes(ctx, walletUuid, kind, hashes); err != nil {
+		return nil, fmt.Errorf("repo.ReplaceOneTimeCodes fail, walletUuid, %s, err: [%w]", walletUuid, err)
+	}
+	return codes, nil
+}
+
+// normalizeCode drops the separators and the case the codes may be typed with, and reads the letters
+// left out of the alphabet as the digits they look like
+func normalizeCode(code string) string {
+	return strings.NewReplacer("-", "", " ", "", "o", "0", "i", "1", "l", "1").Replace(strings.ToLower(code))
+}
+
+func hashOneTimeCode(walletUuid, normalized string) string {
+	sum := sha256.Sum256([]byte(walletUuid + ":" + normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,269 @@
+package keydispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/savour-labs/key-locker/config"
+	"github.com/savour-labs/key-locker/crypto"
+	"github.com/savour-labs/key-locker/model"
+	"github.com/savour-labs/key-locker/notify"
+	"github.com/savour-labs/key-locker/proto/keylocker"
+	"gorm.io/gorm"
+)
+
+// transferTarget is how the keys are written to the target wallet. A wallet of a public key is created
+// before the first key is written, the adaptors only need valid credentials to encrypt for it.
+type transferTarget struct {
+	walletUuid string
+	password   string
+	socialCode string
+	publicKey  string
+	create     bool
+}
+
+// TransferSocialKey re-encrypts the keys of the wallet for another wallet or public key, writes them to
+// the same backend and marks the old keys as superseded
+func (d *Dispatcher) TransferSocialKey(ctx context.Context, req *keylocker.TransferSocialKeyReq) (*keylocker.TransferSocialKeyRep, error) {
+	if msg := checkTransfer(req); msg != "" {
+		return &keylocker.TransferSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  msg,
+		}, nil
+	}
+	rsaObj, err := d.unlockWallet(ctx, req.WalletUuid, req.Password, req.SocialCode)
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.TransferSocialKeyRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := d.checkFactors(ctx, req.WalletUuid, factors{sessionToken: req.SessionToken, totpCode: req.TotpCode, recoveryCode: req.RecoveryCode}); err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.TransferSocialKeyRep{
+				Code: code,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+	target, err := d.transferTarget(ctx, req)
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.TransferSocialKeyRep{
+			Code:       code,
+			Msg:        fmt.Sprintf("new wallet: %v", err),
+			Violations: policyViolations(err),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return &keylocker.TransferSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  "new_wallet_uuid is owned by another key",
+		}, nil
+	}
+
+	adaptor, ok := d.acquire(req.Chain)
+	if !ok {
+		return &keylocker.TransferSocialKeyRep{
+			Code: keylocker.ReturnCode_ERROR,
+			Msg:  config.UnsupportedOperation,
+		}, nil
+	}
+	defer adaptor.release()
+	rc, err := d.claimRecovery(ctx, req.WalletUuid, req.Chain, req.RecoveryCaseId)
+	if code := failureCode(err); err != nil && code != keylocker.ReturnCode_ERROR {
+		return &keylocker.TransferSocialKeyRep{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	keyRep, err := d.getSocialKey(ctx, adaptor, &keylocker.GetSocialKeyReq{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		WalletUuid:    req.WalletUuid,
+		KeyId:         req.KeyId,
+	})
+	var rep *keylocker.TransferSocialKeyRep
+	switch {
+	case err != nil:
+	case keyRep.Code != keylocker.ReturnCode_SUCCESS:
+		rep = &keylocker.TransferSocialKeyRep{Code: keyRep.Code, Msg: keyRep.Msg}
+	case len(keyRep.KeyList) == 0:
+		rep = &keylocker.TransferSocialKeyRep{Code: keylocker.ReturnCode_NOT_FOUND, Msg: "no social key stored"}
+	default:
+		rep, err = d.transferKeys(ctx, adaptor, rsaObj, req, target, keyRep.KeyList)
+	}
+	d.settleRecovery(ctx, rc, err == nil && rep.Code == keylocker.ReturnCode_SUCCESS)
+	return rep, err
+}
+
+// transferKeys writes the keys to the target until one fails, the keys written are superseded and audited either way
+func (d *Dispatcher) transferKeys(ctx context.Context, adaptor *adaptorEntry, rsaObj *crypto.Rsa, req *keylocker.TransferSocialKeyReq, target *transferTarget, keys []*keylocker.SocialKey) (*keylocker.TransferSocialKeyRep, error) {
+	repo := d.repo()
+	if target.create {
+		if err := repo.CreateSecret(ctx, &model.Secret{KeyUuid: target.walletUuid, RsaPub: target.publicKey}); err != nil {
+			return nil, fmt.Errorf("repo.CreateSecret fail, walletUuid, %s, err: [%w]", target.walletUuid, err)
+		}
+	}
+	rep := &keylocker.TransferSocialKeyRep{
+		Code: keylocker.ReturnCode_SUCCESS,
+		Msg:  "transfer social key success",
+	}
+	var transferErr error
+	keyIds := make([]string, 0, len(keys))
+	write := func() (bool, error) {
+		for _, k := range keys {
+			plain, err := rsaObj.Decrypt([]byte(k.Key))
+			if err != nil {
+				rep.Code, rep.Msg = keylocker.ReturnCode_ERROR, fmt.Sprintf("decrypt key %s fail: %v", k.Id, err)
+				break
+			}
+			setRep, err := adaptor.SetSocialKey(ctx, &keylocker.SetSocialKeyReq{
+				ConsumerToken: req.ConsumerToken,
+				Chain:         req.Chain,
+				WalletUuid:    target.walletUuid,
+				Key:           string(plain),
+				Password:      target.password,
+				SocialCode:    target.socialCode,
+				Label:         k.Label,
+			})
+			if err == nil && setRep.Code == keylocker.ReturnCode_SUCCESS {
+				if err := d.inheritKey(ctx, target.walletUuid, req.Chain, setRep.KeyId, setRep.Version, plain); err != nil {
+					log.Error("hold key for the beneficiary fail", "walletUuid", target.walletUuid, "keyId", setRep.KeyId, "err", err)
+				}
+			}
+			wipe(plain)
+			if err != nil {
+				transferErr = fmt.Errorf("SetSocialKey fail, keyId, %s, err: [%w]", k.Id, err)
+				break
+			}
+			if setRep.Code != keylocker.ReturnCode_SUCCESS {
+				rep.Code, rep.Msg = setRep.Code, fmt.Sprintf("write key %s fail: %s", k.Id, setRep.Msg)
+				break
+			}
+			keyIds = append(keyIds, k.Id)
+			rep.Keys = append(rep.Keys, &keylocker.TransferredKey{
+				KeyId:      k.Id,
+				Version:    k.Version,
+				NewKeyId:   setRep.KeyId,
+				NewVersion: setRep.Version,
+				JobId:      setRep.JobId,
+				TxHash:     setRep.TxHash,
+				FileCid:    setRep.FileCid,
+			})
+		}
+		return len(keyIds) > 0, nil
+	}
+	if target.publicKey != "" {
+		// a wallet of a public key has no social code, the keys are written with the source credentials
+		write()
+	} else if err := d.withSocialCode(ctx, target.walletUuid, target.socialCode, write); err != nil {
+		if code := failureCode(err); code != keylocker.ReturnCode_ERROR {
+			return &keylocker.TransferSocialKeyRep{
+				Code: code,
+				Msg:  fmt.Sprintf("new wallet: %v", err),
+			}, nil
+		}
+		return nil, err
+	}
+	if len(keyIds) == 0 {
+		if transferErr != nil {
+			return nil, transferErr
+		}
+		return rep, nil
+	}
+
+	if err := repo.SupersedeKeys(ctx, req.WalletUuid, req.Chain, keyIds, target.walletUuid); err != nil {
+		return nil, fmt.Errorf("repo.SupersedeKeys fail, req, %v, err: [%w]", req, err)
+	}
+	detail := map[string]interface{}{
+		"to":   target.walletUuid,
+		"keys": rep.Keys,
+	}
+	if target.publicKey != "" {
+		detail["public_key"] = target.publicKey
+	}
+	if rep.Code != keylocker.ReturnCode_SUCCESS {
+		detail["error"] = rep.Msg
+	} else if transferErr != nil {
+		detail["error"] = transferErr.Error()
+	}
+	if err := d.audit(ctx, &model.AuditRecord{
+		Action:  model.AuditActionTransfer,
+		KeyUuid: req.WalletUuid,
+		Chain:   req.Chain,
+	}, detail); err != nil {
+		return nil, fmt.Errorf("audit fail, err: [%w]", err)
+	}
+	d.notify(&notify.Event{Type: notify.EventKeyTransferred, WalletUuid: req.WalletUuid, Chain: req.Chain})
+	if transferErr != nil {
+		rep.Code, rep.Msg = keylocker.ReturnCode_ERROR, transferErr.Error()
+	}
+	return rep, nil
+}
+
+// transferTarget checks the target of the transfer is free or unlocked by the new credentials,
+// it returns nil when the target is a wallet of another owner
+func (d *Dispatcher) transferTarget(ctx context.Context, req *keylocker.TransferSocialKeyReq) (*transferTarget, error) {
+	if req.PublicKey == "" {
+		_, err := d.unlockWallet(ctx, req.NewWalletUuid, req.NewPassword, req.NewSocialCode)
+		if errors.Is(err, errWalletNotFound) {
+			// the new credentials create the wallet
+			err = d.checkNewCredentials(req.NewWalletUuid, req.NewPassword, req.NewSocialCode)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &transferTarget{
+			walletUuid: req.NewWalletUuid,
+			password:   req.NewPassword,
+			socialCode: req.NewSocialCode,
+		}, nil
+	}
+	target := &transferTarget{
+		walletUuid: req.NewWalletUuid,
+		password:   req.Password,
+		socialCode: req.SocialCode,
+		publicKey:  req.PublicKey,
+	}
+	sec, err := d.repo().GetByUID(ctx, req.NewWalletUuid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		target.create = true
+		return target, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetByUID fail, walletUuid, %s, err: [%w]", req.NewWalletUuid, err)
+	}
+	if sec.RsaPriv != "" || strings.TrimSpace(sec.RsaPub) != strings.TrimSpace(req.PublicKey) {
+		return nil, nil
+	}
+	return target, nil
+}
+
+func checkTransfer(req *keylocker.TransferSocialKeyReq) string {
+	switch {
+	case req.WalletUuid == "" || req.NewWalletUuid == "":
+		return "wallet_uuid and new_wallet_uuid are required"
+	case req.WalletUuid == req.NewWalletUuid:
+		return "new_wallet_uuid must differ from wallet_uuid"
+	case req.PublicKey != "" && (req.NewPassword != "" || req.NewSocialCode != ""):
+		return "public_key can not be combined with new_password and new_social_code"
+	case req.PublicKey == "" && (req.NewPassword == "" || req.NewSocialCode == ""):
+		return "new_password and new_social_code, or public_key, are required"
+	}
+	if req.PublicKey != "" {
+		if _, err := crypto.LoadRsa(req.PublicKey, ""); err != nil {
+			return fmt.Sprintf("invalid public_key: %v", err)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,64 @@
+package keydispatcher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/savour-labs/key-locker/proto/keylocker"
+)
+
+func TestTransferSocialKeyMovesKeys(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	ctx := context.Background()
+	storeTestKey(t, d, "wallet", "seed phrase")
+	storeTestKey(t, d, "wallet", "second seed")
+	newPassword, newSocialCode := "Nw5!tRq9yK", "731046"
+
+	req := &keylocker.TransferSocialKeyReq{
+		Chain:         testChain,
+		WalletUuid:    "wallet",
+		Password:      sealed(t, "Wrong#pass1"),
+		SocialCode:    sealed(t, testSocialCode),
+		NewWalletUuid: "heir",
+		NewPassword:   sealed(t, newPassword),
+		NewSocialCode: sealed(t, newSocialCode),
+	}
+	if rep, err := d.TransferSocialKey(ctx, req); err != nil || rep.Code != keylocker.ReturnCode_INVALID_CREDENTIALS {
+		t.Fatalf("transfer with wrong credentials got %v %v, want INVALID_CREDENTIALS", rep, err)
+	}
+	req.Password = sealed(t, testPassword)
+	rep, err := d.TransferSocialKey(ctx, req)
+	if err != nil || rep.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("transfer fail: %v %v", rep, err)
+	}
+	if len(rep.Keys) != 2 {
+		t.Fatalf("%d keys transferred, want 2", len(rep.Keys))
+	}
+
+	recovered, err := d.RecoverSocialKey(ctx, &keylocker.RecoverSocialKeyReq{
+		Chain:      testChain,
+		WalletUuid: "heir",
+		Password:   sealed(t, newPassword),
+		SocialCode: sealed(t, newSocialCode),
+	})
+	if err != nil || recovered.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("recover the new wallet fail: %v %v", recovered, err)
+	}
+	got := make(map[string]bool)
+	for _, k := range recovered.KeyList {
+		got[k.Key] = true
+	}
+	if len(got) != 2 || !got["seed phrase"] || !got["second seed"] {
+		t.Fatalf("the new wallet holds %v, want the transferred keys", got)
+	}
+
+	list, err := d.ListSocialKeys(ctx, &keylocker.ListSocialKeysReq{ConsumerToken: testAdminToken, Chain: testChain, WalletUuid: "wallet"})
+	if err != nil || list.Code != keylocker.ReturnCode_SUCCESS {
+		t.Fatalf("list fail: %v %v", list, err)
+	}
+	for _, k := range list.Keys {
+		if k.SupersededBy != "heir" {
+			t.Fatalf("key %s of the old wallet is superseded by %q, want heir", k.Id, k.SupersededBy)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+const (
+	AuditActionDeleteSocialKey   = "delete_social_key"
+	AuditActionPruneSocialKey    = "prune_social_key"
+	AuditActionInheritance       = "release_inheritance"
+	AuditActionSign              = "sign_with_social_key"
+	AuditActionDeriveAccounts    = "derive_accounts"
+	AuditActionTransfer          = "transfer_social_key"
+	AuditActionCredentials       = "change_credentials"
+	AuditActionVerifyCredentials = "verify_credentials"
+	AuditActionLogin             = "login"
+)
+
+// AuditRecord is an append only trail of the operations which use, remove or change wallet keys,
+// a deleted wallet keeps its records as a tombstone
+type AuditRecord struct {
+	*gorm.Model
+	Action  string `gorm:"index;type:varchar(32);description:Action;comment:操作类型"           json:"action"`
+	KeyUuid string `gorm:"index;type:varchar(256);description:KeyUuid;comment:用户ID"          json:"key_uuid"`
+	Chain   string `gorm:"type:varchar(32);description:Chain;comment:存储的链"                  json:"chain"`
+	Reason  string `gorm:"type:varchar(256);description:Reason;comment:操作原因"                json:"reason"`
+	Detail  string `gorm:"type:text;description:Detail;comment:操作详情"                        json:"detail"`
+}
+
+func (r *Repo) CreateAuditRecord(ctx context.Context, record *AuditRecord) error {
+	return r.DB.WithContext(ctx).Create(record).Error
+}
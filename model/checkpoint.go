@@ -0,0 +1,34 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ImportCheckpoint is the last item of a bulk import which has been processed
+type ImportCheckpoint struct {
+	*gorm.Model
+	ImportId string `gorm:"uniqueIndex;type:varchar(128);description:ImportId;comment:导入任务ID"  json:"import_id"`
+	Seq      uint64 `gorm:"description:Seq;comment:已处理的最后序号"                                 json:"seq"`
+}
+
+func (r *Repo) GetImportCheckpoint(ctx context.Context, importId string) (uint64, error) {
+	res := new(ImportCheckpoint)
+	if err := r.DB.WithContext(ctx).Where("import_id = ?", importId).First(res).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return res.Seq, nil
+}
+
+func (r *Repo) SaveImportCheckpoint(ctx context.Context, importId string, seq uint64) error {
+	return r.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "import_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"seq", "updated_at"}),
+	}).Create(&ImportCheckpoint{ImportId: importId, Seq: seq}).Error
+}
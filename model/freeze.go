@@ -0,0 +1,64 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// WalletFreeze blocks the retrievals of the wallet. It holds until UnfreezeAt, which stays nil until
+// the owner asks for an unfreeze.
+type WalletFreeze struct {
+	*gorm.Model
+	KeyUuid    string     `gorm:"uniqueIndex;type:varchar(256);description:KeyUuid;comment:用户ID"   json:"key_uuid"`
+	Reason     string     `gorm:"type:varchar(256);description:Reason;comment:冻结原因"              json:"reason"`
+	UnfreezeAt *time.Time `gorm:"description:UnfreezeAt;comment:解冻时间"                             json:"unfreeze_at"`
+}
+
+// Frozen reports whether the freeze still holds at now
+func (f *WalletFreeze) Frozen(now time.Time) bool {
+	return f.UnfreezeAt == nil || now.Before(*f.UnfreezeAt)
+}
+
+// FreezeWallet freezes the wallet, a pending unfreeze is cancelled
+func (r *Repo) FreezeWallet(ctx context.Context, uid, reason string) error {
+	return r.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key_uuid"}},
+		DoUpdates: clause.AssignmentColumns([]string{"reason", "unfreeze_at", "updated_at"}),
+	}).Create(&WalletFreeze{KeyUuid: uid, Reason: reason}).Error
+}
+
+// GetWalletFreeze returns the freeze of the wallet, gorm.ErrRecordNotFound when it is not frozen
+func (r *Repo) GetWalletFreeze(ctx context.Context, uid string) (*WalletFreeze, error) {
+	res := new(WalletFreeze)
+	if err := r.DB.WithContext(ctx).Where("key_uuid = ?", uid).First(res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ScheduleUnfreeze sets when the freeze of the wallet ends, unless an earlier end is already set.
+// It returns false when the wallet is not frozen.
+func (r *Repo) ScheduleUnfreeze(ctx context.Context, uid string, at time.Time) (bool, error) {
+	res := r.DB.WithContext(ctx).Model(&WalletFreeze{}).
+		Where("key_uuid = ? AND (unfreeze_at IS NULL OR unfreeze_at > ?)", uid, at).
+		Update("unfreeze_at", at)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 1 {
+		return true, nil
+	}
+	var count int64
+	if err := r.DB.WithContext(ctx).Model(&WalletFreeze{}).Where("key_uuid = ?", uid).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// DeleteEndedFreeze removes the freeze of the wallet when it ended at now, a new freeze is kept
+func (r *Repo) DeleteEndedFreeze(ctx context.Context, uid string, now time.Time) error {
+	return r.DB.WithContext(ctx).Unscoped().Where("key_uuid = ? AND unfreeze_at <= ?", uid, now).Delete(&WalletFreeze{}).Error
+}
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RecoveryPolicy is how many guardians of the wallet have to approve the release of its keys
+type RecoveryPolicy struct {
+	*gorm.Model
+	KeyUuid   string `gorm:"uniqueIndex;type:varchar(256);description:KeyUuid;comment:用户ID"          json:"key_uuid"`
+	Threshold uint32 `gorm:"description:Threshold;comment:需要的守护人批准数"                             json:"threshold"`
+}
+
+type Guardian struct {
+	*gorm.Model
+	KeyUuid string `gorm:"index;type:varchar(256);description:KeyUuid;comment:用户ID"               json:"key_uuid"`
+	Address string `gorm:"type:varchar(42);description:Address;comment:守护人地址"                     json:"address"`
+}
+
+// GuardianChange is a change of the guardians of a wallet waiting for its delay, no guardians removes them
+type GuardianChange struct {
+	*gorm.Model
+	KeyUuid   string    `gorm:"uniqueIndex;type:varchar(256);description:KeyUuid;comment:用户ID"          json:"key_uuid"`
+	Guardians string    `gorm:"type:text;description:Guardians;comment:新的守护人地址,逗号分隔"              json:"guardians"`
+	Threshold uint32    `gorm:"description:Threshold;comment:新的守护人批准数"                           json:"threshold"`
+	ApplyAt   time.Time `gorm:"description:ApplyAt;comment:生效时间"                                   json:"apply_at"`
+}
+
+// Addresses returns the guardian addresses of the change
+func (c *GuardianChange) Addresses() []string {
+	if c.Guardians == "" {
+		return nil
+	}
+	return strings.Split(c.Guardians, ",")
+}
+
+// SetGuardians replaces the guardians of the wallet, no guardians removes the policy
+func (r *Repo) SetGuardians(ctx context.Context, uid string, addresses []string, threshold uint32) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := setGuardians(tx, uid, addresses, threshold); err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("key_uuid = ?", uid).Delete(&GuardianChange{}).Error
+	})
+}
+
+func setGuardians(tx *gorm.DB, uid string, addresses []string, threshold uint32) error {
+	if err := tx.Unscoped().Where("key_uuid = ?", uid).Delete(&Guardian{}).Error; err != nil {
+		return err
+	}
+	if len(addresses) == 0 {
+		return tx.Unscoped().Where("key_uuid = ?", uid).Delete(&RecoveryPolicy{}).Error
+	}
+	if err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key_uuid"}},
+		DoUpdates: clause.AssignmentColumns([]string{"threshold", "updated_at"}),
+	}).Create(&RecoveryPolicy{KeyUuid: uid, Threshold: threshold}).Error; err != nil {
+		return err
+	}
+	guardians := make([]*Guardian, 0, len(addresses))
+	for _, addr := range addresses {
+		guardians = append(guardians, &Guardian{KeyUuid: uid, Address: addr})
+	}
+	return tx.Create(&guardians).Error
+}
+
+// SetGuardianChange schedules the guardians of the wallet to be replaced at applyAt, replacing the change
+// scheduled before
+func (r *Repo) SetGuardianChange(ctx context.Context, uid string, addresses []string, threshold uint32, applyAt time.Time) error {
+	return r.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key_uuid"}},
+		DoUpdates: clause.AssignmentColumns([]string{"guardians", "threshold", "apply_at", "updated_at"}),
+	}).Create(&GuardianChange{
+		KeyUuid:   uid,
+		Guardians: strings.Join(addresses, ","),
+		Threshold: threshold,
+		ApplyAt:   applyAt,
+	}).Error
+}
+
+// GetGuardianChange returns the change waiting for the wallet, gorm.ErrRecordNotFound when there is none
+func (r *Repo) GetGuardianChange(ctx context.Context, uid string) (*GuardianChange, error) {
+	res := new(GuardianChange)
+	if err := r.DB.WithContext(ctx).Where("key_uuid = ?", uid).First(res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelGuardianChange drops the change waiting for the wallet, it returns whether there was one
+func (r *Repo) CancelGuardianChange(ctx context.Context, uid string) (bool, error) {
+	res := r.DB.WithContext(ctx).Unscoped().Where("key_uuid = ?", uid).Delete(&GuardianChange{})
+	return res.RowsAffected > 0, res.Error
+}
+
+// applyGuardianChange replaces the guardians of the wallet with its change once the change is due
+func (r *Repo) applyGuardianChange(ctx context.Context, uid string, now time.Time) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		change := new(GuardianChange)
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("key_uuid = ? AND apply_at <= ?", uid, now).First(change).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := setGuardians(tx, uid, change.Addresses(), change.Threshold); err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(change).Error
+	})
+}
+
+// GetRecoveryPolicy returns the policy and the guardian addresses of the wallet,
+// gorm.ErrRecordNotFound when the wallet has no guardians. A change of the guardians that is due is applied first.
+func (r *Repo) GetRecoveryPolicy(ctx context.Context, uid string) (*RecoveryPolicy, []string, error) {
+	if err := r.applyGuardianChange(ctx, uid, time.Now()); err != nil {
+		return nil, nil, err
+	}
+	policy := new(RecoveryPolicy)
+	if err := r.DB.WithContext(ctx).Where("key_uuid = ?", uid).First(policy).Error; err != nil {
+		return nil, nil, err
+	}
+	var addresses []string
+	if err := r.DB.WithContext(ctx).Model(&Guardian{}).Where("key_uuid = ?", uid).Order("id").Pluck("address", &addresses).Error; err != nil {
+		return nil, nil, err
+	}
+	return policy, addresses, nil
+}
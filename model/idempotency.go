@@ -0,0 +1,66 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	IdempotencyPending = "pending"
+	IdempotencyDone    = "done"
+)
+
+// IdempotencyRecord keeps the response of a request sent with an idempotency key
+type IdempotencyRecord struct {
+	*gorm.Model
+	ConsumerToken  string `gorm:"uniqueIndex:idx_consumer_idempotency_key;type:varchar(256);description:ConsumerToken;comment:调用方"  json:"consumer_token"`
+	IdempotencyKey string `gorm:"uniqueIndex:idx_consumer_idempotency_key;type:varchar(128);description:IdempotencyKey;comment:幂等键" json:"idempotency_key"`
+	RequestHash    string `gorm:"type:varchar(64);description:RequestHash;comment:请求摘要"                                            json:"request_hash"`
+	Status         string `gorm:"type:varchar(16);description:Status;comment:pending,done"                                         json:"status"`
+	Response       []byte `gorm:"type:mediumblob;description:Response;comment:序列化的响应"                                             json:"response"`
+}
+
+// CreateIdempotencyRecord inserts a pending record, it returns false when the key is already taken
+func (r *Repo) CreateIdempotencyRecord(ctx context.Context, rec *IdempotencyRecord) (bool, error) {
+	rec.Status = IdempotencyPending
+	res := r.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(rec)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (r *Repo) GetIdempotencyRecord(ctx context.Context, consumerToken, key string) (*IdempotencyRecord, error) {
+	res := new(IdempotencyRecord)
+	if err := r.DB.WithContext(ctx).Where("consumer_token = ? AND idempotency_key = ?", consumerToken, key).First(res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repo) FinishIdempotencyRecord(ctx context.Context, rec *IdempotencyRecord, response []byte) error {
+	return r.DB.WithContext(ctx).Model(rec).Updates(map[string]interface{}{
+		"status":   IdempotencyDone,
+		"response": response,
+	}).Error
+}
+
+// TakeOverIdempotencyRecord claims a pending record left by a request which never finished, it returns false
+// when another request took it over or finished it meanwhile
+func (r *Repo) TakeOverIdempotencyRecord(ctx context.Context, rec *IdempotencyRecord, now time.Time) (bool, error) {
+	res := r.DB.WithContext(ctx).Model(&IdempotencyRecord{}).
+		Where("id = ? AND status = ? AND updated_at = ?", rec.ID, IdempotencyPending, rec.UpdatedAt).
+		Update("updated_at", now)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// DeleteIdempotencyRecord frees the key so the request can be retried with it
+func (r *Repo) DeleteIdempotencyRecord(ctx context.Context, rec *IdempotencyRecord) error {
+	return r.DB.WithContext(ctx).Unscoped().Delete(rec).Error
+}
@@ -0,0 +1,197 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	InheritanceStatusActive   = "active"
+	InheritanceStatusWarned   = "warned"
+	InheritanceStatusReleased = "released"
+)
+
+// InheritancePolicy releases the keys of the wallet to the beneficiary once the owner stopped sending
+// heartbeats for Period seconds. The keys are re-encrypted to the beneficiary while the owner is around
+// and held back as InheritedKey until the release, the locker never holds the rsa private key of the wallet.
+type InheritancePolicy struct {
+	*gorm.Model
+	KeyUuid        string    `gorm:"uniqueIndex;type:varchar(256);description:KeyUuid;comment:用户ID"       json:"key_uuid"`
+	BeneficiaryPub string    `gorm:"type:text;description:BeneficiaryPub;comment:继承人RSA公钥"               json:"beneficiary_pub"`
+	Period         uint64    `gorm:"description:Period;comment:不活跃秒数"                                   json:"period"`
+	WarnBefore     uint64    `gorm:"description:WarnBefore;comment:提前提醒秒数"                              json:"warn_before"`
+	Status         string    `gorm:"index;type:varchar(16);description:Status;comment:继承状态"              json:"status"`
+	LastHeartbeat  time.Time `gorm:"description:LastHeartbeat;comment:最后心跳时间"                           json:"last_heartbeat"`
+	WarnAt         time.Time `gorm:"index;description:WarnAt;comment:提醒时间"                              json:"warn_at"`
+	Deadline       time.Time `gorm:"index;description:Deadline;comment:释放时间"                            json:"deadline"`
+}
+
+// InheritedKey is the latest version of a key of the wallet re-encrypted to the public key of the beneficiary,
+// it is only returned once the policy is released
+type InheritedKey struct {
+	*gorm.Model
+	KeyUuid string `gorm:"index;type:varchar(256);description:KeyUuid;comment:用户ID"      json:"key_uuid"`
+	Chain   string `gorm:"type:varchar(32);description:Chain;comment:存储的链"              json:"chain"`
+	KeyId   string `gorm:"type:varchar(64);description:KeyId;comment:key的ID"            json:"key_id"`
+	Label   string `gorm:"type:varchar(64);description:Label;comment:key的标签"            json:"label"`
+	Version uint64 `gorm:"description:Version;comment:key的版本"                           json:"version"`
+	Key     string `gorm:"type:text;description:Key;comment:用继承人公钥加密的key"              json:"key"`
+}
+
+// Beat moves the deadlines of the policy after a heartbeat at the time
+func (p *InheritancePolicy) Beat(now time.Time) {
+	p.LastHeartbeat = now
+	p.Deadline = now.Add(time.Duration(p.Period) * time.Second)
+	p.WarnAt = p.Deadline.Add(-time.Duration(p.WarnBefore) * time.Second)
+	p.Status = InheritanceStatusActive
+}
+
+// SaveInheritancePolicy replaces the policy of the wallet and its keys, the keys held by the previous one are removed
+func (r *Repo) SaveInheritancePolicy(ctx context.Context, policy *InheritancePolicy, keys []*InheritedKey) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := deleteInheritance(tx, policy.KeyUuid); err != nil {
+			return err
+		}
+		if err := tx.Create(policy).Error; err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+		return tx.Create(&keys).Error
+	})
+}
+
+// SaveInheritedKey holds the key for the beneficiary of the policy of the wallet in place of its older versions.
+// It reports false when the wallet has no policy or the policy is released already, then nothing is stored.
+func (r *Repo) SaveInheritedKey(ctx context.Context, key *InheritedKey) (bool, error) {
+	saved := false
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		policy := new(InheritancePolicy)
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("key_uuid = ?", key.KeyUuid).First(policy).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil || policy.Status == InheritanceStatusReleased {
+			return err
+		}
+		if err := tx.Unscoped().Where("key_uuid = ? AND chain = ? AND key_id = ?", key.KeyUuid, key.Chain, key.KeyId).
+			Delete(&InheritedKey{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(key).Error; err != nil {
+			return err
+		}
+		saved = true
+		return nil
+	})
+	return saved, err
+}
+
+// DeleteInheritedKeys removes the keys of the wallet on the chain held for its beneficiary
+func (r *Repo) DeleteInheritedKeys(ctx context.Context, uid, chain string) error {
+	return r.DB.WithContext(ctx).Unscoped().Where("key_uuid = ? AND chain = ?", uid, chain).Delete(&InheritedKey{}).Error
+}
+
+// DropInheritanceEscrow drops the escrow column, where the policies set before the keys were held
+// for the beneficiary kept the rsa private key of the wallet
+func (r *Repo) DropInheritanceEscrow() error {
+	migrator := r.DB.Migrator()
+	if !migrator.HasColumn(&InheritancePolicy{}, "escrow") {
+		return nil
+	}
+	return migrator.DropColumn(&InheritancePolicy{}, "escrow")
+}
+
+func (r *Repo) GetInheritancePolicy(ctx context.Context, uid string) (*InheritancePolicy, error) {
+	res := new(InheritancePolicy)
+	if err := r.DB.WithContext(ctx).Where("key_uuid = ?", uid).First(res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteInheritancePolicy removes the policy of the wallet and the keys it released
+func (r *Repo) DeleteInheritancePolicy(ctx context.Context, uid string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return deleteInheritance(tx, uid)
+	})
+}
+
+func deleteInheritance(tx *gorm.DB, uid string) error {
+	if err := tx.Unscoped().Where("key_uuid = ?", uid).Delete(&InheritedKey{}).Error; err != nil {
+		return err
+	}
+	return tx.Unscoped().Where("key_uuid = ?", uid).Delete(&InheritancePolicy{}).Error
+}
+
+// Heartbeat moves the deadlines of the policy of the wallet unless it is released already,
+// it reports false when there was nothing to update
+func (r *Repo) Heartbeat(ctx context.Context, policy *InheritancePolicy, now time.Time) (bool, error) {
+	policy.Beat(now)
+	res := r.DB.WithContext(ctx).Model(&InheritancePolicy{}).
+		Where("key_uuid = ? AND status <> ?", policy.KeyUuid, InheritanceStatusReleased).
+		Updates(map[string]interface{}{
+			"status":         policy.Status,
+			"last_heartbeat": policy.LastHeartbeat,
+			"warn_at":        policy.WarnAt,
+			"deadline":       policy.Deadline,
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// ListInheritanceToWarn returns the active policies whose warning time has passed
+func (r *Repo) ListInheritanceToWarn(ctx context.Context, now time.Time) ([]*InheritancePolicy, error) {
+	var res []*InheritancePolicy
+	if err := r.DB.WithContext(ctx).Where("status = ? AND warn_at <= ?", InheritanceStatusActive, now).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListInheritanceDue returns the policies whose deadline has passed and that are not released yet
+func (r *Repo) ListInheritanceDue(ctx context.Context, now time.Time) ([]*InheritancePolicy, error) {
+	var res []*InheritancePolicy
+	if err := r.DB.WithContext(ctx).Where("status IN ? AND deadline <= ?", []string{InheritanceStatusActive, InheritanceStatusWarned}, now).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarkInheritanceWarned reports false when the policy was not active anymore, a heartbeat came in meanwhile
+func (r *Repo) MarkInheritanceWarned(ctx context.Context, uid string, warnAt time.Time) (bool, error) {
+	res := r.DB.WithContext(ctx).Model(&InheritancePolicy{}).
+		Where("key_uuid = ? AND status = ? AND warn_at = ?", uid, InheritanceStatusActive, warnAt).
+		Update("status", InheritanceStatusWarned)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// ReleaseInheritance marks the policy released, which gives the beneficiary the keys held for it, unless
+// a heartbeat moved its deadline meanwhile, in which case it reports false
+func (r *Repo) ReleaseInheritance(ctx context.Context, policy *InheritancePolicy) (bool, error) {
+	res := r.DB.WithContext(ctx).Model(&InheritancePolicy{}).
+		Where("key_uuid = ? AND status <> ? AND deadline = ?", policy.KeyUuid, InheritanceStatusReleased, policy.Deadline).
+		Update("status", InheritanceStatusReleased)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (r *Repo) ListInheritedKeys(ctx context.Context, uid string) ([]*InheritedKey, error) {
+	var res []*InheritedKey
+	if err := r.DB.WithContext(ctx).Where("key_uuid = ?", uid).Order("id").Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInheritanceBeat(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	p := &InheritancePolicy{Period: 3600, WarnBefore: 600, Status: InheritanceStatusWarned}
+	p.Beat(now)
+	assert.Equal(t, InheritanceStatusActive, p.Status)
+	assert.Equal(t, now, p.LastHeartbeat)
+	assert.Equal(t, now.Add(time.Hour), p.Deadline)
+	assert.Equal(t, now.Add(50*time.Minute), p.WarnAt)
+}
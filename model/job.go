@@ -0,0 +1,83 @@
+package model
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+const (
+	JobStatusPending   = "pending"
+	JobStatusMined     = "mined"
+	JobStatusConfirmed = "confirmed"
+	JobStatusReverted  = "reverted"
+	JobStatusDropped   = "dropped"
+)
+
+// Job tracks the transaction sent for a SetSocialKey or DeleteSocialKey until it is final
+type Job struct {
+	*gorm.Model
+	JobId                 string `gorm:"uniqueIndex;type:varchar(64);description:JobId;comment:任务ID"            json:"job_id"`
+	KeyUuid               string `gorm:"index;type:varchar(256);description:KeyUuid;comment:用户ID"              json:"key_uuid"`
+	Chain                 string `gorm:"index;type:varchar(32);description:Chain;comment:存储的链"                json:"chain"`
+	TxHash                string `gorm:"index;type:varchar(66);description:TxHash;comment:交易哈希"              json:"tx_hash"`
+	Status                string `gorm:"index;type:varchar(16);description:Status;comment:交易状态"              json:"status"`
+	BlockNumber           uint64 `gorm:"description:BlockNumber;comment:打包区块"                                json:"block_number"`
+	Confirmations         uint64 `gorm:"description:Confirmations;comment:当前确认数"                             json:"confirmations"`
+	RequiredConfirmations uint64 `gorm:"description:RequiredConfirmations;comment:需要的确认数"                    json:"required_confirmations"`
+}
+
+// IsFinal reports whether the job status can not change anymore
+func IsFinal(status string) bool {
+	return status == JobStatusConfirmed || status == JobStatusReverted || status == JobStatusDropped
+}
+
+func (r *Repo) CreateJob(ctx context.Context, job *Job) error {
+	return r.DB.WithContext(ctx).Create(job).Error
+}
+
+func (r *Repo) GetJob(ctx context.Context, jobId string) (*Job, error) {
+	res := new(Job)
+	if err := r.DB.WithContext(ctx).Where("job_id = ?", jobId).First(res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UpdateJobStatus updates the job of the transaction. The key versions reserved for a transaction which
+// reverted or was dropped are deleted, they hold nothing on the contract and must not hide the versions before.
+func (r *Repo) UpdateJobStatus(ctx context.Context, txHash, status string, blockNumber, confirmations uint64) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&Job{}).Where("tx_hash = ?", txHash).Updates(map[string]interface{}{
+			"status":        status,
+			"block_number":  blockNumber,
+			"confirmations": confirmations,
+		}).Error; err != nil {
+			return err
+		}
+		if status != JobStatusReverted && status != JobStatusDropped {
+			return nil
+		}
+		return tx.Where("tx_hash = ?", txHash).Delete(&Key{}).Error
+	})
+}
+
+// ListUnfinishedJobs returns the jobs of the chain whose transaction still has to be tracked
+func (r *Repo) ListUnfinishedJobs(ctx context.Context, chain string) ([]*Job, error) {
+	var res []*Job
+	if err := r.DB.WithContext(ctx).Where("chain = ? AND status IN ?", chain, []string{JobStatusPending, JobStatusMined}).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repo) ListJobsByTxHashes(ctx context.Context, txHashes []string) ([]*Job, error) {
+	var res []*Job
+	if len(txHashes) == 0 {
+		return res, nil
+	}
+	if err := r.DB.WithContext(ctx).Where("tx_hash IN ?", txHashes).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
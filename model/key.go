@@ -1,13 +1,32 @@
 package model
 
 import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+var ErrKeyNotFound = errors.New("key not found")
+
 type Key struct {
 	KeySecret string `gorm:"type:text;description:KeySecret; comment: uid的rsa私钥"    json:"key_secret"`
 	KeyCID    string `gorm:"type:varchar(256);;description:KeyCID; comment: key对应的ipfs CID"    json:"key_cid"`
-	KeyUuid   string `gorm:"index;type:varchar(256);description:KeyUuid; comment: 用户ID"    json:"key_uuid"`
+	KeyUuid   string `gorm:"index;index:idx_key_version,priority:1;type:varchar(256);description:KeyUuid; comment: 用户ID"    json:"key_uuid"`
+	Chain     string `gorm:"index;index:idx_key_version,priority:2;type:varchar(32);description:Chain; comment: 存储的链"    json:"chain"`
+	TxHash    string `gorm:"type:varchar(66);description:TxHash; comment: 写入合约的交易哈希"    json:"tx_hash"`
+	Scheme    string `gorm:"type:varchar(32);default:rsa2048-pkcs1v15;description:Scheme; comment: key的加密方式"    json:"scheme"`
+	KeyId     string `gorm:"index:idx_key_version,priority:3;type:varchar(64);description:KeyId; comment: key的ID"    json:"key_id"`
+	Label     string `gorm:"type:varchar(64);description:Label; comment: key的标签"    json:"label"`
+	Version   uint64 `gorm:"index:idx_key_version,priority:4;description:Version; comment: key的版本"    json:"version"`
+	Slot      string `gorm:"type:varchar(66);description:Slot; comment: 合约中的存储位置，为空时由ID和版本计算"    json:"slot"`
+	// SupersededBy is the wallet the key was transferred to
+	SupersededBy string `gorm:"type:varchar(256);description:SupersededBy; comment: key转移到的钱包ID"    json:"superseded_by"`
 	*gorm.Model
 }
 
@@ -18,3 +37,243 @@ type Repo struct {
 func NewRepo(db *gorm.DB) *Repo {
 	return &Repo{DB: db}
 }
+
+// Close closes the connection pool of the repo
+func (r *Repo) Close() error {
+	sqlDB, err := r.DB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+// ListKeysByUID returns the keys of the wallet stored on the chain, oldest first,
+// an empty chain lists the keys of all chains
+func (r *Repo) ListKeysByUID(ctx context.Context, uid, chain string) ([]*Key, error) {
+	var res []*Key
+	tx := r.DB.WithContext(ctx).Where("key_uuid = ?", uid)
+	if chain != "" {
+		tx = tx.Where("chain = ?", chain)
+	}
+	if err := tx.Order("id").Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateKeyVersion stores key as the next version of key.KeyId, or of the key holding key.Label when
+// no KeyId is given, otherwise as the first version of a new key. A version is never reused, even once pruned.
+func (r *Repo) CreateKeyVersion(ctx context.Context, key *Key) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var latest Key
+		if key.KeyId != "" || key.Label != "" {
+			query := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("key_uuid = ? AND chain = ?", key.KeyUuid, key.Chain)
+			if key.KeyId != "" {
+				query = query.Where("key_id = ?", key.KeyId)
+			} else {
+				query = query.Where("label = ?", key.Label)
+			}
+			err := query.Order("version DESC").First(&latest).Error
+			switch {
+			case errors.Is(err, gorm.ErrRecordNotFound) && key.KeyId != "":
+				return ErrKeyNotFound
+			case err != nil && !errors.Is(err, gorm.ErrRecordNotFound):
+				return err
+			}
+		}
+		if latest.KeyId == "" {
+			key.KeyId, key.Version = uuid.NewString(), 1
+			return tx.Create(key).Error
+		}
+		var maxVersion uint64
+		if err := tx.Unscoped().Model(&Key{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("key_uuid = ? AND chain = ? AND key_id = ?", key.KeyUuid, key.Chain, latest.KeyId).
+			Select("COALESCE(MAX(version), 0)").Scan(&maxVersion).Error; err != nil {
+			return err
+		}
+		key.KeyId, key.Version = latest.KeyId, maxVersion+1
+		if key.Label == "" {
+			key.Label = latest.Label
+		}
+		return tx.Create(key).Error
+	})
+}
+
+// GetKeyVersion returns the version of the key, version 0 is the latest
+func (r *Repo) GetKeyVersion(ctx context.Context, uid, chain, keyId string, version uint64) (*Key, error) {
+	res := new(Key)
+	tx := r.DB.WithContext(ctx).Where("key_uuid = ? AND chain = ? AND key_id = ?", uid, chain, keyId)
+	if version > 0 {
+		tx = tx.Where("version = ?", version)
+	}
+	err := tx.Order("version DESC").First(res).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListKeyVersions returns the versions of the key left, oldest first
+func (r *Repo) ListKeyVersions(ctx context.Context, uid, chain, keyId string) ([]*Key, error) {
+	var res []*Key
+	if err := r.DB.WithContext(ctx).Where("key_uuid = ? AND chain = ? AND key_id = ?", uid, chain, keyId).Order("version").Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListLatestKeys returns the latest version of every key of the wallet on the chain, by creation of the key
+func (r *Repo) ListLatestKeys(ctx context.Context, uid, chain string) ([]*Key, error) {
+	keys, err := r.ListKeysByUID(ctx, uid, chain)
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[string]int)
+	res := make([]*Key, 0, len(keys))
+	for _, k := range keys {
+		if i, ok := index[k.KeyId]; ok {
+			if k.Version > res[i].Version {
+				res[i] = k
+			}
+			continue
+		}
+		index[k.KeyId] = len(res)
+		res = append(res, k)
+	}
+	return res, nil
+}
+
+// UpdateKey sets the fields known once the key is stored on the backend
+func (r *Repo) UpdateKey(ctx context.Context, id uint, fields map[string]interface{}) error {
+	return r.DB.WithContext(ctx).Model(&Key{}).Where("id = ?", id).Updates(fields).Error
+}
+
+// DeleteKeys soft deletes the keys by their row ids
+func (r *Repo) DeleteKeys(ctx context.Context, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.DB.WithContext(ctx).Where("id IN ?", ids).Delete(&Key{}).Error
+}
+
+// BackfillKeyVersions upgrades the keys stored before key ids and chains. A key with a CID was stored on ipfsChain
+// and becomes version 1 of its own key. The other keys were written under the wallet uuid on the evm chain chainOf
+// finds holding it, each write replacing the one before, so they become the versions of a single key and only the
+// latest is still under the uuid.
+func (r *Repo) BackfillKeyVersions(ctx context.Context, ipfsChain string, chainOf func(ctx context.Context, uid string) (string, error)) error {
+	var legacy []*Key
+	if err := r.DB.WithContext(ctx).Unscoped().
+		Where("key_id = '' OR key_id IS NULL OR chain = '' OR chain IS NULL").
+		Order("id").Find(&legacy).Error; err != nil {
+		return err
+	}
+	var wallets []string
+	written := make(map[string][]*Key)
+	for _, k := range legacy {
+		if k.KeyCID != "" {
+			if err := r.backfillKey(ctx, k, strconv.FormatUint(uint64(k.ID), 10), 1, ipfsChain, ""); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, ok := written[k.KeyUuid]; !ok {
+			wallets = append(wallets, k.KeyUuid)
+		}
+		written[k.KeyUuid] = append(written[k.KeyUuid], k)
+	}
+	for _, uid := range wallets {
+		keys := written[uid]
+		chain, err := chainOf(ctx, uid)
+		if err != nil {
+			return fmt.Errorf("resolve the chain of wallet %s fail, err: [%w]", uid, err)
+		}
+		keyId := strconv.FormatUint(uint64(keys[0].ID), 10)
+		for i, k := range keys {
+			// the overwritten versions get the slot of their own version, which holds nothing
+			slot := ""
+			if i == len(keys)-1 {
+				var uuidSlot [32]byte
+				copy(uuidSlot[:], uid)
+				slot = "0x" + hex.EncodeToString(uuidSlot[:])
+			}
+			if err := r.backfillKey(ctx, k, keyId, uint64(i+1), chain, slot); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Repo) backfillKey(ctx context.Context, k *Key, keyId string, version uint64, chain, slot string) error {
+	return r.DB.WithContext(ctx).Unscoped().Model(&Key{}).Where("id = ?", k.ID).Updates(map[string]interface{}{
+		"key_id":  keyId,
+		"version": version,
+		"chain":   chain,
+		"slot":    slot,
+	}).Error
+}
+
+func (r *Repo) GetKeyByCID(ctx context.Context, uid, cid string) (*Key, error) {
+	res := new(Key)
+	if err := r.DB.WithContext(ctx).Where("key_uuid = ? AND key_cid = ?", uid, cid).First(res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SupersedeKeys marks every version of the keys of the wallet on the chain as transferred to the wallet to
+func (r *Repo) SupersedeKeys(ctx context.Context, uid, chain string, keyIds []string, to string) error {
+	if len(keyIds) == 0 {
+		return nil
+	}
+	return r.DB.WithContext(ctx).Model(&Key{}).Where("key_uuid = ? AND chain = ? AND key_id IN ?", uid, chain, keyIds).
+		Update("superseded_by", to).Error
+}
+
+// DeleteKeysByUID soft deletes the keys of the wallet stored on the chain
+func (r *Repo) DeleteKeysByUID(ctx context.Context, uid, chain string) error {
+	return r.DB.WithContext(ctx).Where("key_uuid = ? AND chain = ?", uid, chain).Delete(&Key{}).Error
+}
+
+// CountKeysByUID counts the keys of the wallet left on all chains
+func (r *Repo) CountKeysByUID(ctx context.Context, uid string) (int64, error) {
+	var count int64
+	if err := r.DB.WithContext(ctx).Model(&Key{}).Where("key_uuid = ?", uid).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SelectKeys returns the version of the key when keyId is set, otherwise the latest version of every key
+func (r *Repo) SelectKeys(ctx context.Context, uid, chain, keyId string, version uint64) ([]*Key, error) {
+	if keyId == "" {
+		return r.ListLatestKeys(ctx, uid, chain)
+	}
+	key, err := r.GetKeyVersion(ctx, uid, chain, keyId, version)
+	if err != nil {
+		return nil, err
+	}
+	return []*Key{key}, nil
+}
+
+// PrunableVersions returns the versions older than before, 0 selecting all but the latest,
+// the latest version is never pruned. versions must be sorted by version.
+func PrunableVersions(versions []*Key, before uint64) []*Key {
+	if len(versions) == 0 {
+		return nil
+	}
+	latest := versions[len(versions)-1].Version
+	if before == 0 || before > latest {
+		before = latest
+	}
+	var res []*Key
+	for _, k := range versions {
+		if k.Version < before {
+			res = append(res, k)
+		}
+	}
+	return res
+}
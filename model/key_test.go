@@ -0,0 +1,102 @@
+package model
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrunableVersions(t *testing.T) {
+	versions := []*Key{{Version: 1}, {Version: 2}, {Version: 4}}
+	pruned := func(before uint64) []uint64 {
+		var res []uint64
+		for _, k := range PrunableVersions(versions, before) {
+			res = append(res, k.Version)
+		}
+		return res
+	}
+	assert.Equal(t, []uint64{1, 2}, pruned(0))
+	assert.Equal(t, []uint64{1}, pruned(2))
+	assert.Equal(t, []uint64{1, 2}, pruned(9))
+	assert.Nil(t, pruned(1))
+	assert.Nil(t, PrunableVersions(nil, 0))
+}
+
+func TestBackfillKeyVersions(t *testing.T) {
+	repo := newTestRepo(t)
+	ctx := context.Background()
+	legacy := []*Key{
+		{KeyUuid: "w1"},
+		{KeyUuid: "w1", KeyCID: "cid"},
+		{KeyUuid: "w2"},
+		{KeyUuid: "w1"},
+	}
+	for _, k := range legacy {
+		assert.NoError(t, repo.DB.Create(k).Error)
+	}
+	chains := map[string]string{"w1": "Moonbeam", "w2": "Ethereum"}
+	chainOf := func(ctx context.Context, uid string) (string, error) {
+		return chains[uid], nil
+	}
+	assert.NoError(t, repo.BackfillKeyVersions(ctx, "Ipfs", chainOf))
+
+	keys, err := repo.ListLatestKeys(ctx, "w1", "Moonbeam")
+	assert.NoError(t, err)
+	if assert.Len(t, keys, 1) {
+		assert.Equal(t, legacy[3].ID, keys[0].ID)
+		assert.Equal(t, uint64(2), keys[0].Version)
+		assert.Equal(t, "0x7731"+strings.Repeat("0", 60), keys[0].Slot)
+	}
+	first, err := repo.GetKeyVersion(ctx, "w1", "Moonbeam", keys[0].KeyId, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, legacy[0].ID, first.ID)
+	assert.Empty(t, first.Slot)
+
+	stored, err := repo.ListLatestKeys(ctx, "w1", "Ipfs")
+	assert.NoError(t, err)
+	if assert.Len(t, stored, 1) {
+		assert.Equal(t, "cid", stored[0].KeyCID)
+		assert.Equal(t, uint64(1), stored[0].Version)
+	}
+	// the keys of each wallet are labelled with the chain holding them
+	other, err := repo.ListLatestKeys(ctx, "w2", "Ethereum")
+	assert.NoError(t, err)
+	assert.Len(t, other, 1)
+
+	// a second run finds nothing left to upgrade
+	assert.NoError(t, repo.BackfillKeyVersions(ctx, "Ipfs", func(ctx context.Context, uid string) (string, error) {
+		return "Ethereum", nil
+	}))
+	keys, err = repo.ListLatestKeys(ctx, "w1", "Moonbeam")
+	assert.NoError(t, err)
+	assert.Len(t, keys, 1)
+}
+
+func TestFailedWriteReleasesVersion(t *testing.T) {
+	repo := newTestRepo(t)
+	ctx := context.Background()
+	first := &Key{KeyUuid: "w1", Chain: "Ethereum"}
+	assert.NoError(t, repo.CreateKeyVersion(ctx, first))
+	assert.NoError(t, repo.UpdateKey(ctx, first.ID, map[string]interface{}{"tx_hash": "0x01"}))
+	second := &Key{KeyUuid: "w1", Chain: "Ethereum", KeyId: first.KeyId}
+	assert.NoError(t, repo.CreateKeyVersion(ctx, second))
+	assert.NoError(t, repo.UpdateKey(ctx, second.ID, map[string]interface{}{"tx_hash": "0x02"}))
+	assert.NoError(t, repo.CreateJob(ctx, &Job{JobId: "j2", KeyUuid: "w1", Chain: "Ethereum", TxHash: "0x02", Status: JobStatusPending}))
+
+	assert.NoError(t, repo.UpdateJobStatus(ctx, "0x02", JobStatusReverted, 10, 1))
+	keys, err := repo.ListLatestKeys(ctx, "w1", "Ethereum")
+	assert.NoError(t, err)
+	if assert.Len(t, keys, 1) {
+		assert.Equal(t, first.ID, keys[0].ID)
+	}
+	job, err := repo.GetJob(ctx, "j2")
+	assert.NoError(t, err)
+	assert.Equal(t, JobStatusReverted, job.Status)
+
+	// the version of the reverted write is not reused
+	third := &Key{KeyUuid: "w1", Chain: "Ethereum", KeyId: first.KeyId}
+	assert.NoError(t, repo.CreateKeyVersion(ctx, third))
+	assert.Equal(t, uint64(3), third.Version)
+}
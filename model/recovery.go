@@ -0,0 +1,157 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// a case is open until the guardians approved it, then pending until it is released or cancelled.
+// A pending case can be released once its release time has passed.
+const (
+	RecoveryStatusOpen      = "open"
+	RecoveryStatusPending   = "pending"
+	RecoveryStatusReleased  = "released"
+	RecoveryStatusCancelled = "cancelled"
+)
+
+var ErrInvalidTransition = errors.New("invalid recovery status transition")
+
+var recoveryTransitions = map[string][]string{
+	RecoveryStatusOpen:    {RecoveryStatusPending, RecoveryStatusCancelled},
+	RecoveryStatusPending: {RecoveryStatusReleased, RecoveryStatusCancelled},
+	// a release that failed gives the case back
+	RecoveryStatusReleased: {RecoveryStatusPending},
+}
+
+// CanTransition reports whether a recovery case can move from one status to the other
+func CanTransition(from, to string) bool {
+	for _, s := range recoveryTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// RecoveryCase is a request to release the keys of a wallet, guarded by the approvals of its guardians
+type RecoveryCase struct {
+	*gorm.Model
+	CaseId    string    `gorm:"uniqueIndex;type:varchar(66);description:CaseId;comment:恢复请求ID"      json:"case_id"`
+	KeyUuid   string    `gorm:"index;type:varchar(256);description:KeyUuid;comment:用户ID"            json:"key_uuid"`
+	Chain     string    `gorm:"type:varchar(32);description:Chain;comment:存储的链"                    json:"chain"`
+	Status    string    `gorm:"type:varchar(16);description:Status;comment:恢复状态"                   json:"status"`
+	ReleaseAt time.Time `gorm:"description:ReleaseAt;comment:可以释放的时间"                              json:"release_at"`
+	ExpiresAt time.Time `gorm:"description:ExpiresAt;comment:过期时间"                                  json:"expires_at"`
+}
+
+// RecoveryDelay is the time a recovery case of the wallet waits before release, giving the owner
+// the time to cancel it. A shorter delay only applies once the delay it replaces has passed.
+type RecoveryDelay struct {
+	*gorm.Model
+	KeyUuid      string     `gorm:"uniqueIndex;type:varchar(256);description:KeyUuid;comment:用户ID"   json:"key_uuid"`
+	Delay        uint64     `gorm:"description:Delay;comment:恢复等待秒数"                               json:"delay"`
+	PendingDelay uint64     `gorm:"description:PendingDelay;comment:待生效的等待秒数"                     json:"pending_delay"`
+	PendingAt    *time.Time `gorm:"description:PendingAt;comment:待生效的时间"                           json:"pending_at"`
+}
+
+// Effective returns the delay in seconds at the time
+func (d *RecoveryDelay) Effective(now time.Time) uint64 {
+	if d.PendingAt != nil && !now.Before(*d.PendingAt) {
+		return d.PendingDelay
+	}
+	return d.Delay
+}
+
+// RecoveryApproval is the EIP-712 signature of a guardian over a recovery case
+type RecoveryApproval struct {
+	*gorm.Model
+	CaseId    string `gorm:"uniqueIndex:idx_case_guardian;type:varchar(66);description:CaseId;comment:恢复请求ID"  json:"case_id"`
+	Guardian  string `gorm:"uniqueIndex:idx_case_guardian;type:varchar(42);description:Guardian;comment:守护人地址" json:"guardian"`
+	Signature string `gorm:"type:varchar(132);description:Signature;comment:守护人签名"                          json:"signature"`
+}
+
+func (r *Repo) CreateRecoveryCase(ctx context.Context, rc *RecoveryCase) error {
+	return r.DB.WithContext(ctx).Create(rc).Error
+}
+
+func (r *Repo) GetRecoveryCase(ctx context.Context, caseId string) (*RecoveryCase, error) {
+	res := new(RecoveryCase)
+	if err := r.DB.WithContext(ctx).Where("case_id = ?", caseId).First(res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CountActiveRecoveryCases counts the cases of the wallet still open or pending and not expired at now
+func (r *Repo) CountActiveRecoveryCases(ctx context.Context, uid string, now time.Time) (int64, error) {
+	var n int64
+	err := r.DB.WithContext(ctx).Model(&RecoveryCase{}).
+		Where("key_uuid = ? AND status IN ? AND expires_at > ?", uid, []string{RecoveryStatusOpen, RecoveryStatusPending}, now).
+		Count(&n).Error
+	return n, err
+}
+
+// UpdateRecoveryStatus moves the case from one status to another, it reports false when the case
+// was not in the from status anymore
+func (r *Repo) UpdateRecoveryStatus(ctx context.Context, caseId, from, to string) (bool, error) {
+	if !CanTransition(from, to) {
+		return false, fmt.Errorf("%w: %s to %s", ErrInvalidTransition, from, to)
+	}
+	res := r.DB.WithContext(ctx).Model(&RecoveryCase{}).Where("case_id = ? AND status = ?", caseId, from).Update("status", to)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// AddRecoveryApproval stores the approval, a guardian approving twice keeps the first signature
+func (r *Repo) AddRecoveryApproval(ctx context.Context, approval *RecoveryApproval) error {
+	return r.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(approval).Error
+}
+
+// ListRecoveryApprovers returns the guardians who approved the case
+func (r *Repo) ListRecoveryApprovers(ctx context.Context, caseId string) ([]string, error) {
+	var guardians []string
+	if err := r.DB.WithContext(ctx).Model(&RecoveryApproval{}).Where("case_id = ?", caseId).Order("id").Pluck("guardian", &guardians).Error; err != nil {
+		return nil, err
+	}
+	return guardians, nil
+}
+
+// GetRecoveryDelay returns the delay of the wallet, gorm.ErrRecordNotFound when it never had one
+func (r *Repo) GetRecoveryDelay(ctx context.Context, uid string) (*RecoveryDelay, error) {
+	res := new(RecoveryDelay)
+	if err := r.DB.WithContext(ctx).Where("key_uuid = ?", uid).First(res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SetRecoveryDelay sets the delay of the wallet in seconds. A longer delay applies at once, a shorter one
+// once the current delay has passed, so that stolen credentials can not shorten the window of the owner.
+func (r *Repo) SetRecoveryDelay(ctx context.Context, uid string, delay uint64, now time.Time) (*RecoveryDelay, error) {
+	res := &RecoveryDelay{KeyUuid: uid}
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("key_uuid = ?", uid).First(res).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		current := res.Effective(now)
+		if delay >= current {
+			res.Delay, res.PendingDelay, res.PendingAt = delay, 0, nil
+		} else {
+			at := now.Add(time.Duration(current) * time.Second)
+			res.Delay, res.PendingDelay, res.PendingAt = current, delay, &at
+		}
+		return tx.Save(res).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanTransition(t *testing.T) {
+	assert.True(t, CanTransition(RecoveryStatusOpen, RecoveryStatusPending))
+	assert.True(t, CanTransition(RecoveryStatusPending, RecoveryStatusCancelled))
+	assert.True(t, CanTransition(RecoveryStatusReleased, RecoveryStatusPending))
+	assert.False(t, CanTransition(RecoveryStatusOpen, RecoveryStatusReleased))
+	assert.False(t, CanTransition(RecoveryStatusCancelled, RecoveryStatusPending))
+	assert.False(t, CanTransition(RecoveryStatusReleased, RecoveryStatusCancelled))
+}
+
+func TestRecoveryDelayEffective(t *testing.T) {
+	now := time.Now()
+	at := now.Add(time.Hour)
+	d := &RecoveryDelay{Delay: 172800, PendingDelay: 60, PendingAt: &at}
+	assert.Equal(t, uint64(172800), d.Effective(now))
+	assert.Equal(t, uint64(60), d.Effective(at))
+	assert.Equal(t, uint64(0), (&RecoveryDelay{}).Effective(now))
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// newTestRepo returns a repo on an in-memory sqlite database with every table migrated
+func newTestRepo(t *testing.T) *Repo {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("open sqlite fail: %v", err)
+	}
+	if err := db.AutoMigrate(
+		&Key{}, &Secret{}, &ImportCheckpoint{}, &IdempotencyRecord{}, &Job{}, &AuditRecord{},
+		&RecoveryPolicy{}, &Guardian{}, &GuardianChange{}, &RecoveryCase{}, &RecoveryApproval{}, &RecoveryDelay{},
+		&InheritancePolicy{}, &InheritedKey{}, &ShareSet{}, &Share{}, &WalletFreeze{},
+		&LoginChallenge{}, &Session{}, &TotpSecret{}, &OneTimeCode{}, &IssuedSocialCode{},
+	); err != nil {
+		t.Fatalf("migrate sqlite fail: %v", err)
+	}
+	repo := NewRepo(db)
+	t.Cleanup(func() { _ = repo.Close() })
+	return repo
+}
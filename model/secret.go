@@ -2,8 +2,10 @@ package model
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Secret struct {
@@ -11,6 +13,31 @@ type Secret struct {
 	KeyUuid string `gorm:"type:varchar(256);description:KeyUuid;comment:用户ID"          json:"key_uuid"`
 	RsaPriv string `gorm:"type:text;description:RsaPriv;comment:RSA私钥"         json:"rsa_priv"`
 	RsaPub  string `gorm:"type:text;description:RsaPub;comment:RSA公钥"          json:"rsa_pub"`
+	// CredentialVersion is bumped when the password or social code change, invalidating what was issued before
+	CredentialVersion uint64 `gorm:"description:CredentialVersion;comment:凭证版本" json:"credential_version"`
+	// Failures counts the wrong credentials given since the last success or lock
+	Failures    uint32     `gorm:"description:Failures;comment:连续验证失败次数"        json:"failures"`
+	LockedUntil *time.Time `gorm:"description:LockedUntil;comment:锁定截止时间"       json:"locked_until"`
+	// OwnerAddress is the ethereum account of the owner, whose signatures authenticate the owner
+	OwnerAddress string `gorm:"type:varchar(42);description:OwnerAddress;comment:所有者地址" json:"owner_address"`
+	// CredentialsChangedAt is when the credentials were last changed, nil while they are the ones the wallet was created with
+	CredentialsChangedAt *time.Time `gorm:"description:CredentialsChangedAt;comment:凭证修改时间" json:"credentials_changed_at"`
+}
+
+// CredentialsSetAt is when the current credentials of the wallet were set
+func (s *Secret) CredentialsSetAt() time.Time {
+	if s.CredentialsChangedAt != nil {
+		return *s.CredentialsChangedAt
+	}
+	if s.Model == nil {
+		return time.Time{}
+	}
+	return s.CreatedAt
+}
+
+// Locked reports whether the credentials of the wallet can not be tried at now
+func (s *Secret) Locked(now time.Time) bool {
+	return s.LockedUntil != nil && now.Before(*s.LockedUntil)
 }
 
 func (r *Repo) GetByUID(ctx context.Context, uid string) (*Secret, error) {
@@ -20,3 +47,67 @@ func (r *Repo) GetByUID(ctx context.Context, uid string) (*Secret, error) {
 	}
 	return res, nil
 }
+
+// CreateSecret stores the rsa key pair of a wallet. RsaPriv is empty for the wallets transferred to a public key,
+// their owner holds the private key.
+func (r *Repo) CreateSecret(ctx context.Context, sec *Secret) error {
+	return r.DB.WithContext(ctx).Create(sec).Error
+}
+
+// RewrapSecret replaces the encrypted rsa private key of the wallet and bumps its credential version,
+// it returns false when the key changed since it was read as oldPriv
+func (r *Repo) RewrapSecret(ctx context.Context, uid, oldPriv, newPriv string, now time.Time) (bool, error) {
+	res := r.DB.WithContext(ctx).Model(&Secret{}).Where("key_uuid = ? AND rsa_priv = ?", uid, oldPriv).Updates(map[string]interface{}{
+		"rsa_priv":               newPriv,
+		"credential_version":     gorm.Expr("credential_version + 1"),
+		"credentials_changed_at": now,
+	})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// AddCredentialFailure counts a wrong password or social code for the wallet, the maxFailures-th failure
+// locks it for lock and resets the count. It returns the lock deadline, nil while not locked.
+func (r *Repo) AddCredentialFailure(ctx context.Context, uid string, maxFailures uint32, lock time.Duration, now time.Time) (*time.Time, error) {
+	var lockedUntil *time.Time
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		sec := new(Secret)
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("key_uuid = ?", uid).First(sec).Error; err != nil {
+			return err
+		}
+		fields := map[string]interface{}{"failures": sec.Failures + 1}
+		if sec.Failures+1 >= maxFailures {
+			until := now.Add(lock)
+			lockedUntil = &until
+			fields = map[string]interface{}{"failures": 0, "locked_until": until}
+		}
+		return tx.Model(&Secret{}).Where("id = ?", sec.ID).Updates(fields).Error
+	})
+	return lockedUntil, err
+}
+
+// ResetCredentialFailures clears the failures counted for the wallet once its credentials were verified
+func (r *Repo) ResetCredentialFailures(ctx context.Context, uid string) error {
+	return r.DB.WithContext(ctx).Model(&Secret{}).Where("key_uuid = ?", uid).Update("failures", 0).Error
+}
+
+// SetOwnerAddress sets the ethereum account of the owner of the wallet, empty removes it
+func (r *Repo) SetOwnerAddress(ctx context.Context, uid, address string) error {
+	return r.DB.WithContext(ctx).Model(&Secret{}).Where("key_uuid = ?", uid).Update("owner_address", address).Error
+}
+
+// ListSecrets pages through the wallets by id, starting after afterID
+func (r *Repo) ListSecrets(ctx context.Context, afterID uint, limit int) ([]*Secret, error) {
+	var res []*Secret
+	if err := r.DB.WithContext(ctx).Where("id > ?", afterID).Order("id").Limit(limit).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteSecretByUID soft deletes the rsa key pair of the wallet, the next key stored for it gets a new pair
+func (r *Repo) DeleteSecretByUID(ctx context.Context, uid string) error {
+	return r.DB.WithContext(ctx).Where("key_uuid = ?", uid).Delete(&Secret{}).Error
+}
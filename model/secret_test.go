@@ -0,0 +1,27 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestSecretLocked(t *testing.T) {
+	now := time.Now()
+	until := now.Add(time.Minute)
+	sec := &Secret{LockedUntil: &until}
+	assert.True(t, sec.Locked(now))
+	assert.False(t, sec.Locked(until))
+	assert.False(t, (&Secret{}).Locked(now))
+}
+
+func TestSecretCredentialsSetAt(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	sec := &Secret{Model: &gorm.Model{CreatedAt: created}}
+	assert.Equal(t, created, sec.CredentialsSetAt())
+	changed := time.Now()
+	sec.CredentialsChangedAt = &changed
+	assert.Equal(t, changed, sec.CredentialsSetAt())
+}
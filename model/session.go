@@ -0,0 +1,75 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// LoginChallenge is an EIP-4361 message issued to the owner address of a wallet, it can be signed
+// once before it expires
+type LoginChallenge struct {
+	*gorm.Model
+	Nonce     string     `gorm:"uniqueIndex;type:varchar(64);description:Nonce;comment:随机数"       json:"nonce"`
+	KeyUuid   string     `gorm:"index;type:varchar(256);description:KeyUuid;comment:用户ID"         json:"key_uuid"`
+	Address   string     `gorm:"type:varchar(42);description:Address;comment:所有者地址"             json:"address"`
+	Message   string     `gorm:"type:text;description:Message;comment:签名消息"                     json:"message"`
+	ExpiresAt time.Time  `gorm:"description:ExpiresAt;comment:过期时间"                             json:"expires_at"`
+	UsedAt    *time.Time `gorm:"description:UsedAt;comment:使用时间"                                json:"used_at"`
+}
+
+// Session is a login of the owner address of a wallet. Only the hash of its token is stored.
+type Session struct {
+	*gorm.Model
+	TokenHash         string    `gorm:"uniqueIndex;type:varchar(64);description:TokenHash;comment:令牌哈希" json:"token_hash"`
+	KeyUuid           string    `gorm:"index;type:varchar(256);description:KeyUuid;comment:用户ID"        json:"key_uuid"`
+	Address           string    `gorm:"type:varchar(42);description:Address;comment:所有者地址"            json:"address"`
+	CredentialVersion uint64    `gorm:"description:CredentialVersion;comment:凭证版本"                    json:"credential_version"`
+	ExpiresAt         time.Time `gorm:"description:ExpiresAt;comment:过期时间"                            json:"expires_at"`
+}
+
+func (r *Repo) CreateLoginChallenge(ctx context.Context, c *LoginChallenge) error {
+	return r.DB.WithContext(ctx).Create(c).Error
+}
+
+func (r *Repo) GetLoginChallenge(ctx context.Context, nonce string) (*LoginChallenge, error) {
+	res := new(LoginChallenge)
+	if err := r.DB.WithContext(ctx).Where("nonce = ?", nonce).First(res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UseLoginChallenge marks the challenge as used, it returns false when it was used or expired meanwhile
+func (r *Repo) UseLoginChallenge(ctx context.Context, nonce string, now time.Time) (bool, error) {
+	res := r.DB.WithContext(ctx).Model(&LoginChallenge{}).
+		Where("nonce = ? AND used_at IS NULL AND expires_at > ?", nonce, now).
+		Update("used_at", now)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (r *Repo) CreateSession(ctx context.Context, s *Session) error {
+	return r.DB.WithContext(ctx).Create(s).Error
+}
+
+func (r *Repo) GetSession(ctx context.Context, tokenHash string) (*Session, error) {
+	res := new(Session)
+	if err := r.DB.WithContext(ctx).Where("token_hash = ?", tokenHash).First(res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteExpiredLogins removes the challenges and sessions of the wallet which expired at now
+func (r *Repo) DeleteExpiredLogins(ctx context.Context, uid string, now time.Time) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("key_uuid = ? AND expires_at <= ?", uid, now).Delete(&LoginChallenge{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("key_uuid = ? AND expires_at <= ?", uid, now).Delete(&Session{}).Error
+	})
+}
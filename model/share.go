@@ -0,0 +1,130 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// a share set is pending while its shares are written to the backends, then active until a refresh retires it
+const (
+	ShareSetStatusPending = "pending"
+	ShareSetStatusActive  = "active"
+	ShareSetStatusRetired = "retired"
+	ShareSetStatusFailed  = "failed"
+)
+
+// SharePolicyCredentials shares are released like the keys of the wallet, SharePolicyGuardians shares
+// also need the wallet to have guardians, so that they are never released on the credentials alone
+const (
+	SharePolicyCredentials = "credentials"
+	SharePolicyGuardians   = "guardians"
+)
+
+var ErrShareSetChanged = errors.New("active share set changed")
+
+// ShareSet is a generation of the TSS shares of a wallet, a refresh replaces the whole set
+type ShareSet struct {
+	*gorm.Model
+	SetId   string `gorm:"uniqueIndex;type:varchar(64);description:SetId;comment:分片集ID"     json:"set_id"`
+	KeyUuid string `gorm:"index;type:varchar(256);description:KeyUuid;comment:用户ID"        json:"key_uuid"`
+	Epoch   uint64 `gorm:"description:Epoch;comment:分片集代数"                                 json:"epoch"`
+	Status  string `gorm:"type:varchar(16);description:Status;comment:分片集状态"               json:"status"`
+}
+
+// Share is a share of a set, stored as the version of a key of the wallet on the chain
+type Share struct {
+	*gorm.Model
+	SetId      string `gorm:"uniqueIndex:idx_set_share;type:varchar(64);description:SetId;comment:分片集ID" json:"set_id"`
+	ShareIndex uint32 `gorm:"uniqueIndex:idx_set_share;description:ShareIndex;comment:分片序号"              json:"share_index"`
+	KeyUuid    string `gorm:"index;type:varchar(256);description:KeyUuid;comment:用户ID"                   json:"key_uuid"`
+	Chain      string `gorm:"type:varchar(32);description:Chain;comment:存储的链"                           json:"chain"`
+	KeyId      string `gorm:"type:varchar(64);description:KeyId;comment:key的ID"                         json:"key_id"`
+	Version    uint64 `gorm:"description:Version;comment:key的版本"                                        json:"version"`
+	Policy     string `gorm:"type:varchar(16);description:Policy;comment:释放策略"                          json:"policy"`
+}
+
+func (r *Repo) CreateShareSet(ctx context.Context, set *ShareSet) error {
+	return r.DB.WithContext(ctx).Create(set).Error
+}
+
+// GetActiveShareSet returns the active set of the wallet, gorm.ErrRecordNotFound when it has none
+func (r *Repo) GetActiveShareSet(ctx context.Context, uid string) (*ShareSet, error) {
+	res := new(ShareSet)
+	if err := r.DB.WithContext(ctx).Where("key_uuid = ? AND status = ?", uid, ShareSetStatusActive).First(res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repo) AddShare(ctx context.Context, share *Share) error {
+	return r.DB.WithContext(ctx).Create(share).Error
+}
+
+// ListShares returns the shares of the set by index
+func (r *Repo) ListShares(ctx context.Context, setId string) ([]*Share, error) {
+	var res []*Share
+	if err := r.DB.WithContext(ctx).Where("set_id = ?", setId).Order("share_index").Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListShareKeyIds returns the keys of the wallet holding a share of any set, they are only released
+// through the share custody
+func (r *Repo) ListShareKeyIds(ctx context.Context, uid string) ([]string, error) {
+	var res []string
+	if err := r.DB.WithContext(ctx).Model(&Share{}).Where("key_uuid = ?", uid).Distinct().Pluck("key_id", &res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ActivateShareSet makes the pending set the active set of the wallet and retires the set it replaces,
+// replaceSetId is empty when the wallet had no set. It returns ErrShareSetChanged when the active set is
+// not replaceSetId anymore.
+func (r *Repo) ActivateShareSet(ctx context.Context, uid, setId, replaceSetId string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the wallet row serializes the activations of the wallet
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("key_uuid = ?", uid).First(&Secret{}).Error; err != nil {
+			return err
+		}
+		var active ShareSet
+		err := tx.Where("key_uuid = ? AND status = ?", uid, ShareSetStatusActive).First(&active).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if active.SetId != replaceSetId {
+			return ErrShareSetChanged
+		}
+		if active.SetId != "" {
+			if err := tx.Model(&ShareSet{}).Where("set_id = ?", active.SetId).Update("status", ShareSetStatusRetired).Error; err != nil {
+				return err
+			}
+		}
+		res := tx.Model(&ShareSet{}).Where("set_id = ? AND status = ?", setId, ShareSetStatusPending).Update("status", ShareSetStatusActive)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected != 1 {
+			return ErrShareSetChanged
+		}
+		return nil
+	})
+}
+
+func (r *Repo) UpdateShareSetStatus(ctx context.Context, setId, status string) error {
+	return r.DB.WithContext(ctx).Model(&ShareSet{}).Where("set_id = ?", setId).Update("status", status).Error
+}
+
+// DeleteSharesByUID removes the share sets of the wallet
+func (r *Repo) DeleteSharesByUID(ctx context.Context, uid string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("key_uuid = ?", uid).Delete(&Share{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("key_uuid = ?", uid).Delete(&ShareSet{}).Error
+	})
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// IssuedSocialCode is a social code the locker issued for a wallet and delivered through a channel,
+// only its hash is stored. A single use code is spent by the first key stored with it.
+type IssuedSocialCode struct {
+	*gorm.Model
+	CodeId      string     `gorm:"uniqueIndex;type:varchar(66);description:CodeId;comment:社交码ID"   json:"code_id"`
+	KeyUuid     string     `gorm:"index;type:varchar(256);description:KeyUuid;comment:用户ID"       json:"key_uuid"`
+	Channel     string     `gorm:"type:varchar(32);description:Channel;comment:发送渠道"             json:"channel"`
+	CodeHash    string     `gorm:"type:varchar(64);description:CodeHash;comment:社交码哈希"          json:"-"`
+	Reusable    bool       `gorm:"description:Reusable;comment:是否可重复使用"                         json:"reusable"`
+	ExpiresAt   time.Time  `gorm:"description:ExpiresAt;comment:过期时间"                            json:"expires_at"`
+	Attempts    uint32     `gorm:"description:Attempts;comment:错误尝试次数"                          json:"attempts"`
+	MaxAttempts uint32     `gorm:"description:MaxAttempts;comment:最大尝试次数"                       json:"max_attempts"`
+	UsedAt      *time.Time `gorm:"description:UsedAt;comment:使用时间"                               json:"used_at"`
+	RevokedAt   *time.Time `gorm:"description:RevokedAt;comment:撤销时间"                            json:"revoked_at"`
+}
+
+// Usable reports whether the code can still be used at now
+func (c *IssuedSocialCode) Usable(now time.Time) bool {
+	return c.RevokedAt == nil && now.Before(c.ExpiresAt) && c.Attempts < c.MaxAttempts && (c.Reusable || c.UsedAt == nil)
+}
+
+func (r *Repo) CreateIssuedSocialCode(ctx context.Context, c *IssuedSocialCode) error {
+	return r.DB.WithContext(ctx).Create(c).Error
+}
+
+// ListIssuedSocialCodes returns every code issued for the wallet, the unusable ones included
+func (r *Repo) ListIssuedSocialCodes(ctx context.Context, uid string) ([]*IssuedSocialCode, error) {
+	var res []*IssuedSocialCode
+	if err := r.DB.WithContext(ctx).Where("key_uuid = ?", uid).Order("id").Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UseIssuedSocialCode spends the single use code, it returns false when it was spent meanwhile
+func (r *Repo) UseIssuedSocialCode(ctx context.Context, id uint, now time.Time) (bool, error) {
+	res := r.DB.WithContext(ctx).Model(&IssuedSocialCode{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", now)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// ReleaseIssuedSocialCode gives back a single use code whose key could not be stored
+func (r *Repo) ReleaseIssuedSocialCode(ctx context.Context, id uint) error {
+	return r.DB.WithContext(ctx).Model(&IssuedSocialCode{}).Where("id = ?", id).Update("used_at", nil).Error
+}
+
+// AddSocialCodeAttempt counts a wrong social code against the codes of the wallet which are not expired
+func (r *Repo) AddSocialCodeAttempt(ctx context.Context, uid string, now time.Time) error {
+	return r.DB.WithContext(ctx).Model(&IssuedSocialCode{}).
+		Where("key_uuid = ? AND revoked_at IS NULL AND expires_at > ?", uid, now).
+		Update("attempts", gorm.Expr("attempts + 1")).Error
+}
+
+// RevokeIssuedSocialCodes revokes the code of the wallet, every code of it when codeId is empty
+func (r *Repo) RevokeIssuedSocialCodes(ctx context.Context, uid, codeId string, now time.Time) (int64, error) {
+	db := r.DB.WithContext(ctx).Model(&IssuedSocialCode{}).Where("key_uuid = ? AND revoked_at IS NULL", uid)
+	if codeId != "" {
+		db = db.Where("code_id = ?", codeId)
+	}
+	res := db.Update("revoked_at", now)
+	return res.RowsAffected, res.Error
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuedSocialCodeUsable(t *testing.T) {
+	now := time.Now()
+	code := func() *IssuedSocialCode {
+		return &IssuedSocialCode{ExpiresAt: now.Add(time.Hour), MaxAttempts: 3}
+	}
+	assert.True(t, code().Usable(now))
+
+	expired := code()
+	assert.False(t, expired.Usable(expired.ExpiresAt))
+
+	revoked := code()
+	revoked.RevokedAt = &now
+	assert.False(t, revoked.Usable(now))
+
+	exhausted := code()
+	exhausted.Attempts = 3
+	assert.False(t, exhausted.Usable(now))
+
+	used := code()
+	used.UsedAt = &now
+	assert.False(t, used.Usable(now))
+	used.Reusable = true
+	assert.True(t, used.Usable(now))
+}
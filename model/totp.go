@@ -0,0 +1,108 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// kinds of the one-time codes of a wallet
+const (
+	CodeKindTotpBackup = "totp_backup"
+	CodeKindRecovery   = "recovery"
+)
+
+// TotpSecret is the RFC 6238 secret of a wallet, encrypted with the aes_key. It is only enforced once
+// a first code confirmed the enrollment. LastCounter is the time step of the last code accepted, a code
+// is never accepted twice.
+type TotpSecret struct {
+	*gorm.Model
+	KeyUuid     string `gorm:"uniqueIndex;type:varchar(256);description:KeyUuid;comment:用户ID"  json:"key_uuid"`
+	Secret      string `gorm:"type:varchar(256);description:Secret;comment:加密的TOTP密钥"       json:"-"`
+	Enabled     bool   `gorm:"description:Enabled;comment:是否已启用"                             json:"enabled"`
+	LastCounter uint64 `gorm:"description:LastCounter;comment:最后使用的时间步"                    json:"last_counter"`
+}
+
+// OneTimeCode is the hash of a code of a wallet which can be used once
+type OneTimeCode struct {
+	*gorm.Model
+	KeyUuid  string     `gorm:"index:idx_code_wallet;type:varchar(256);description:KeyUuid;comment:用户ID" json:"key_uuid"`
+	Kind     string     `gorm:"index:idx_code_wallet;type:varchar(16);description:Kind;comment:类型"       json:"kind"`
+	CodeHash string     `gorm:"type:varchar(64);description:CodeHash;comment:代码哈希"                      json:"-"`
+	UsedAt   *time.Time `gorm:"description:UsedAt;comment:使用时间"                                          json:"used_at"`
+}
+
+// SaveTotpSecret starts a new enrollment of the wallet, replacing the secret before
+func (r *Repo) SaveTotpSecret(ctx context.Context, uid, secret string) error {
+	return r.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key_uuid"}},
+		DoUpdates: clause.AssignmentColumns([]string{"secret", "enabled", "last_counter", "updated_at"}),
+	}).Create(&TotpSecret{KeyUuid: uid, Secret: secret}).Error
+}
+
+// GetTotpSecret returns the totp secret of the wallet, gorm.ErrRecordNotFound when it has none
+func (r *Repo) GetTotpSecret(ctx context.Context, uid string) (*TotpSecret, error) {
+	res := new(TotpSecret)
+	if err := r.DB.WithContext(ctx).Where("key_uuid = ?", uid).First(res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UseTotpCounter records the time step of an accepted code and enables the secret. It returns false
+// when a code of the same or a later time step was accepted meanwhile.
+func (r *Repo) UseTotpCounter(ctx context.Context, uid string, counter uint64) (bool, error) {
+	res := r.DB.WithContext(ctx).Model(&TotpSecret{}).
+		Where("key_uuid = ? AND last_counter < ?", uid, counter).
+		Updates(map[string]interface{}{"last_counter": counter, "enabled": true})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// DeleteTotpSecret removes the totp secret and the backup codes of the wallet
+func (r *Repo) DeleteTotpSecret(ctx context.Context, uid string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("key_uuid = ?", uid).Delete(&TotpSecret{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("key_uuid = ? AND kind = ?", uid, CodeKindTotpBackup).Delete(&OneTimeCode{}).Error
+	})
+}
+
+// ReplaceOneTimeCodes replaces the codes of the kind of the wallet by the hashes
+func (r *Repo) ReplaceOneTimeCodes(ctx context.Context, uid, kind string, hashes []string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("key_uuid = ? AND kind = ?", uid, kind).Delete(&OneTimeCode{}).Error; err != nil {
+			return err
+		}
+		codes := make([]*OneTimeCode, 0, len(hashes))
+		for _, h := range hashes {
+			codes = append(codes, &OneTimeCode{KeyUuid: uid, Kind: kind, CodeHash: h})
+		}
+		return tx.Create(&codes).Error
+	})
+}
+
+// UseOneTimeCode burns the unused code of the kind of the wallet, it returns false when there is none
+func (r *Repo) UseOneTimeCode(ctx context.Context, uid, kind, hash string, now time.Time) (bool, error) {
+	res := r.DB.WithContext(ctx).Model(&OneTimeCode{}).
+		Where("key_uuid = ? AND kind = ? AND code_hash = ? AND used_at IS NULL", uid, kind, hash).
+		Update("used_at", now)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// CountOneTimeCodes counts the unused codes of the kind of the wallet
+func (r *Repo) CountOneTimeCodes(ctx context.Context, uid, kind string) (int64, error) {
+	var count int64
+	err := r.DB.WithContext(ctx).Model(&OneTimeCode{}).
+		Where("key_uuid = ? AND kind = ? AND used_at IS NULL", uid, kind).
+		Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,100 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/savour-labs/key-locker/config"
+)
+
+const (
+	EventRecoveryRequested        = "recovery_requested"
+	EventRecoveryCancelled        = "recovery_cancelled"
+	EventRecoveryReleased         = "recovery_released"
+	EventGuardiansChanged         = "guardians_changed"
+	EventGuardiansChangeRequested = "guardians_change_requested"
+	EventDelayChanged             = "recovery_delay_changed"
+
+	EventInheritanceChanged  = "inheritance_changed"
+	EventInheritanceWarning  = "inheritance_warning"
+	EventInheritanceReleased = "inheritance_released"
+
+	EventKeyTransferred     = "key_transferred"
+	EventCredentialsChanged = "credentials_changed"
+
+	EventWalletFrozen      = "wallet_frozen"
+	EventUnfreezeRequested = "unfreeze_requested"
+	EventWalletUnfrozen    = "wallet_unfrozen"
+	EventOwnerChanged      = "owner_changed"
+
+	EventTotpEnabled  = "totp_enabled"
+	EventTotpDisabled = "totp_disabled"
+
+	EventRecoveryCodesGenerated = "recovery_codes_generated"
+	EventRecoveryCodeUsed       = "recovery_code_used"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// Event is sent to the owner of the wallet, the consumer maps the wallet to its owner
+type Event struct {
+	Type       string `json:"type"`
+	WalletUuid string `json:"wallet_uuid"`
+	Chain      string `json:"chain,omitempty"`
+	CaseId     string `json:"case_id,omitempty"`
+	// ReleaseAt is when the recovery case can be released or the inheritance deadline
+	ReleaseAt int64 `json:"release_at,omitempty"`
+	Time      int64 `json:"time"`
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, event *Event) error
+}
+
+// New returns a notifier for every channel of the config
+func New(conf *config.Notify) []Notifier {
+	timeout := time.Duration(conf.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	var res []Notifier
+	for _, url := range conf.Webhooks {
+		res = append(res, NewWebhook(url, timeout))
+	}
+	return res
+}
+
+// Webhook posts the events as json to the url
+type Webhook struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhook(url string, timeout time.Duration) *Webhook {
+	return &Webhook{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+func (w *Webhook) Notify(ctx context.Context, event *Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook %s answered %s", w.url, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWebhook(t *testing.T) {
+	var got Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+	event := &Event{Type: EventRecoveryRequested, WalletUuid: "wallet", CaseId: "0x01", ReleaseAt: 1700000000, Time: 1699827200}
+	if err := NewWebhook(srv.URL, time.Second).Notify(context.Background(), event); err != nil {
+		t.Fatalf("notify fail: %v", err)
+	}
+	if got != *event {
+		t.Fatalf("webhook got %+v, want %+v", got, *event)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	if err := NewWebhook(failing.URL, time.Second).Notify(context.Background(), event); err == nil {
+		t.Fatal("a failing webhook must return an error")
+	}
+}
@@ -10,6 +10,7 @@ contract KeyLocker is OwnableUpgradeable {
     mapping(bytes32 => bytes[]) public socialKeys;
 
     event keyLockerAppend(bytes32 _uuid, bytes[] _keys);
+    event keyLockerDelete(bytes32 _uuid);
 
     function initialize() public initializer {
         __Ownable_init();
@@ -25,6 +26,14 @@ contract KeyLocker is OwnableUpgradeable {
         emit keyLockerAppend(_uuid, _keys);
     }
 
+    function deleteSocialKey(bytes32 _uuid)
+    public
+    onlyOwner
+    {
+        delete socialKeys[_uuid];
+        emit keyLockerDelete(_uuid);
+    }
+
     function getSocialKey(bytes32 _uuid)
     public
     view
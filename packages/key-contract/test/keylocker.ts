@@ -21,6 +21,15 @@ describe("Test KeyLocker", function() {
         expect(uuidKey[0]).to.eq("0x1000000000000000000000000000000000000000")
     })
 
+    it("test delete function", async () => {
+        const uuid = ethers.utils.solidityKeccak256(['string'], ["0x000000001"]);
+        const keys = ["0x1000000000000000000000000000000000000000"]
+        await keyLocker.setSocialKey(uuid, keys)
+        await expect(keyLocker.deleteSocialKey(uuid)).to.emit(keyLocker, "keyLockerDelete").withArgs(uuid)
+        const uuidKey = await keyLocker.getSocialKey(uuid)
+        expect(uuidKey.length).to.eq(0)
+    })
+
     const deployKeyLocker = async () => {
         const factory = await hre.ethers.getContractFactory("KeyLocker");
         keyLocker = await factory.deploy();
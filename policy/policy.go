@@ -0,0 +1,118 @@
+package policy
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/savour-labs/key-locker/config"
+)
+
+// Rules of the credential policy, a violation names the rule it breaks
+const (
+	RuleMinEntropy          = "min_entropy"
+	RuleBannedPassword      = "banned_password"
+	RuleMinSocialCodeLength = "min_social_code_length"
+	RuleMaxAge              = "max_age"
+)
+
+// hints tell the user how to avoid the weakest pattern of a password
+var hints = map[string]string{
+	PatternDictionary: "avoid common passwords and words, even l33t spoken or reversed",
+	PatternRepeat:     "avoid repeated characters and words",
+	PatternSequence:   "avoid sequences like abc or 6543",
+	PatternKeyboard:   "avoid neighbour keys like qwerty or asdf",
+	PatternYear:       "avoid recent years",
+	"":                "add more words or characters",
+}
+
+// Violation is a rule of the credential policy broken by some credentials, its message can be shown to the user
+type Violation struct {
+	Rule    string
+	Message string
+	// Pattern is the weakest pattern of a password breaking min_entropy
+	Pattern string
+}
+
+// Policy is the credential policy of the config, a rule without a limit is not checked
+type Policy struct {
+	MinEntropy          float64
+	MinSocialCodeLength int
+	MaxAge              time.Duration
+	banned              map[string]bool
+}
+
+// Load builds the credential policy of the config and reads its banned passwords file,
+// with a password per line and the lines starting with # skipped
+func Load(conf config.Credentials) (*Policy, error) {
+	p := &Policy{
+		MinEntropy:          conf.MinEntropy,
+		MinSocialCodeLength: conf.MinSocialCodeLength,
+		MaxAge:              time.Duration(conf.MaxAge) * time.Second,
+		banned:              make(map[string]bool),
+	}
+	if conf.BannedPasswords == "" {
+		return p, nil
+	}
+	f, err := os.Open(conf.BannedPasswords)
+	if err != nil {
+		return nil, fmt.Errorf("open banned passwords fail, path, %s, err: [%w]", conf.BannedPasswords, err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		p.banned[strings.ToLower(line)] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read banned passwords fail, path, %s, err: [%w]", conf.BannedPasswords, err)
+	}
+	return p, nil
+}
+
+// CheckCredentials returns the rules broken by a new password and social code, the user inputs
+// are words tied to the user which make a password more guessable
+func (p *Policy) CheckCredentials(password, socialCode string, userInputs ...string) []Violation {
+	if p == nil {
+		return nil
+	}
+	var violations []Violation
+	if p.banned[strings.ToLower(password)] {
+		violations = append(violations, Violation{
+			Rule:    RuleBannedPassword,
+			Message: "the password is banned, choose another one",
+		})
+	} else if p.MinEntropy > 0 {
+		if s := Estimate(password, userInputs...); s.Entropy < p.MinEntropy {
+			violations = append(violations, Violation{
+				Rule:    RuleMinEntropy,
+				Message: fmt.Sprintf("the password is too easy to guess, %.0f bits of %.0f: %s", s.Entropy, p.MinEntropy, hints[s.Pattern]),
+				Pattern: s.Pattern,
+			})
+		}
+	}
+	if n := utf8.RuneCountInString(socialCode); p.MinSocialCodeLength > 0 && n < p.MinSocialCodeLength {
+		violations = append(violations, Violation{
+			Rule:    RuleMinSocialCodeLength,
+			Message: fmt.Sprintf("the social code has %d characters, at least %d are required", n, p.MinSocialCodeLength),
+		})
+	}
+	return violations
+}
+
+// CheckAge returns the violation of credentials set at changedAt once they are older than the max age
+func (p *Policy) CheckAge(changedAt, now time.Time) *Violation {
+	if p == nil || p.MaxAge <= 0 || now.Sub(changedAt) <= p.MaxAge {
+		return nil
+	}
+	return &Violation{
+		Rule:    RuleMaxAge,
+		Message: fmt.Sprintf("the credentials were set on %s and expired after %s, change them", changedAt.UTC().Format("2006-01-02"), p.MaxAge),
+	}
+}
@@ -0,0 +1,98 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/savour-labs/key-locker/config"
+)
+
+func TestEstimate(t *testing.T) {
+	cases := []struct {
+		password string
+		pattern  string
+	}{
+		{"password", PatternDictionary},
+		{"P@ssw0rd", PatternDictionary},
+		{"drowssap", PatternDictionary},
+		{"123456", PatternSequence},
+		{"aaaaaaaa", PatternRepeat},
+		{"abcabcabc", PatternRepeat},
+		{"zxcvbnm,", PatternKeyboard},
+		{"my-wallet!", PatternDictionary},
+	}
+	for _, c := range cases {
+		s := Estimate(c.password, "my-wallet")
+		if s.Score != 0 || s.Pattern != c.pattern {
+			t.Errorf("Estimate(%q) = %+v, want score 0 and pattern %s", c.password, s, c.pattern)
+		}
+	}
+	for _, password := range []string{"Tr0ub4dor&3", "x7#kQ9!mZp", "9f8e7d6c5b4a3c2d"} {
+		if s := Estimate(password); s.Score < 4 || s.Pattern != "" {
+			t.Errorf("Estimate(%q) = %+v, want score 4 without a pattern", password, s)
+		}
+	}
+}
+
+func TestCheckCredentials(t *testing.T) {
+	banned := filepath.Join(t.TempDir(), "banned.txt")
+	if err := os.WriteFile(banned, []byte("# leaked\nCompanyName2024!\n\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	p, err := Load(config.Credentials{MinEntropy: 28, BannedPasswords: banned, MinSocialCodeLength: 6})
+	if err != nil {
+		t.Fatalf("load policy fail: %v", err)
+	}
+	rules := func(violations []Violation) []string {
+		var res []string
+		for _, v := range violations {
+			res = append(res, v.Rule)
+		}
+		return res
+	}
+	cases := []struct {
+		password, socialCode string
+		rules                []string
+	}{
+		{"x7#kQ9!mZp", "123456", nil},
+		{"companyname2024!", "123456", []string{RuleBannedPassword}},
+		{"Password1", "123456", []string{RuleMinEntropy}},
+		{"x7#kQ9!mZp", "1234", []string{RuleMinSocialCodeLength}},
+		{"qwerty", "12", []string{RuleMinEntropy, RuleMinSocialCodeLength}},
+	}
+	for _, c := range cases {
+		got := rules(p.CheckCredentials(c.password, c.socialCode))
+		if len(got) != len(c.rules) {
+			t.Errorf("CheckCredentials(%q, %q) broke %v, want %v", c.password, c.socialCode, got, c.rules)
+			continue
+		}
+		for i := range got {
+			if got[i] != c.rules[i] {
+				t.Errorf("CheckCredentials(%q, %q) broke %v, want %v", c.password, c.socialCode, got, c.rules)
+			}
+		}
+	}
+
+	if _, err := Load(config.Credentials{BannedPasswords: filepath.Join(t.TempDir(), "missing.txt")}); err == nil {
+		t.Error("a missing banned passwords file must fail the load")
+	}
+	if v := (&Policy{}).CheckCredentials("a", ""); v != nil {
+		t.Errorf("an empty policy broke %v", v)
+	}
+}
+
+func TestCheckAge(t *testing.T) {
+	now := time.Now()
+	p := &Policy{MaxAge: 24 * time.Hour}
+	if v := p.CheckAge(now.Add(-time.Hour), now); v != nil {
+		t.Errorf("recent credentials broke %v", v)
+	}
+	if v := p.CheckAge(now.Add(-48*time.Hour), now); v == nil || v.Rule != RuleMaxAge {
+		t.Errorf("old credentials broke %v, want %s", v, RuleMaxAge)
+	}
+	if v := (&Policy{}).CheckAge(now.Add(-48*time.Hour), now); v != nil {
+		t.Errorf("credentials without a max age broke %v", v)
+	}
+}
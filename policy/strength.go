@@ -0,0 +1,386 @@
+package policy
+
+import (
+	"math"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Patterns a password is made of, in the manner of zxcvbn
+const (
+	PatternDictionary = "dictionary"
+	PatternRepeat     = "repeat"
+	PatternSequence   = "sequence"
+	PatternKeyboard   = "keyboard"
+	PatternYear       = "year"
+)
+
+// maxEstimated is the length past which the characters of a password are only counted as brute force
+const maxEstimated = 100
+
+// Strength is how many guesses an attacker trying the likely patterns first needs for a password
+type Strength struct {
+	// Entropy is the log2 of the guesses
+	Entropy float64
+	// Score goes from 0, too guessable, to 4, very unguessable, like the score of zxcvbn
+	Score int
+	// Pattern is the weakest pattern found in the password, empty when it is only brute forced
+	Pattern string
+}
+
+// scoreBits are the guesses of the scores 1 to 4 of zxcvbn, in bits
+var scoreBits = [...]float64{math.Log2(1e3), math.Log2(1e6), math.Log2(1e8), math.Log2(1e10)}
+
+// commonPasswords are ranked by how often they leak, the rank is the guesses of a dictionary match
+var commonPasswords = strings.Fields(`
+	password qwerty iloveyou admin welcome monkey login abc letmein dragon
+	master sunshine princess football baseball shadow superman michael trustno1 starwars
+	hello freedom whatever qazwsx ninja azerty solo loveme donald shalom
+	batman zaq access flower hottie passw0rd charlie jordan hunter ranger
+	buster soccer hockey killer george andrew thomas pepper daniel ginger
+	joshua maggie cookie matrix samsung google apple orange banana chocolate
+	tigger purple silver golden diamond angel jesus family friend forever
+	lovely secret computer summer winter spring autumn money bitcoin ethereum
+	crypto wallet metamask ledger satoshi moon lambo hodl blockchain private
+	mnemonic seed phrase social code love god pass test guest
+	user root default changeme mypass mypassword internet cheese corvette mustang
+	harley ferrari jennifer jessica ashley amanda nicole
+	michelle robert william richard charles joseph matthew anthony mark steven
+`)
+
+var dictionary = rankWords(commonPasswords)
+
+func rankWords(words []string) map[string]int {
+	ranked := make(map[string]int, len(words))
+	for i, w := range words {
+		if _, ok := ranked[w]; !ok {
+			ranked[w] = i + 1
+		}
+	}
+	return ranked
+}
+
+var leet = map[rune]rune{
+	'4': 'a', '@': 'a', '8': 'b', '(': 'c', '3': 'e', '6': 'g', '1': 'i', '!': 'i',
+	'|': 'l', '0': 'o', '$': 's', '5': 's', '7': 't', '+': 't', '2': 'z', '%': 'x',
+}
+
+var keyboardRows = []string{"`1234567890-=", "qwertyuiop[]\\", "asdfghjkl;'", "zxcvbnm,./"}
+
+type match struct {
+	i, j    int
+	bits    float64
+	pattern string
+}
+
+// Estimate estimates the strength of the password, the user inputs are words tied to its user
+// like the wallet uuid, which an attacker tries first
+func Estimate(password string, userInputs ...string) Strength {
+	chars := []rune(password)
+	if len(chars) == 0 {
+		return Strength{}
+	}
+	words := dictionary
+	if len(userInputs) > 0 {
+		words = make(map[string]int, len(dictionary)+len(userInputs))
+		for w, rank := range dictionary {
+			words[w] = rank + len(userInputs)
+		}
+		for i, in := range userInputs {
+			words[strings.ToLower(in)] = i + 1
+		}
+	}
+	estimated := chars
+	if len(estimated) > maxEstimated {
+		estimated = estimated[:maxEstimated]
+	}
+	bits, pattern := minimumBits(estimated, words)
+	bits += float64(len(chars)-len(estimated)) * math.Log2(float64(cardinality(chars)))
+	return Strength{Entropy: bits, Score: score(bits), Pattern: pattern}
+}
+
+func score(bits float64) int {
+	for i, b := range scoreBits {
+		if bits < b {
+			return i
+		}
+	}
+	return len(scoreBits)
+}
+
+// minimumBits finds the sequence of matches which covers the password with the fewest guesses,
+// the characters no match covers are brute forced
+func minimumBits(chars []rune, words map[string]int) (float64, string) {
+	matches := findMatches(chars, words)
+	charBits := math.Log2(float64(cardinality(chars)))
+	best := make([]float64, len(chars)+1)
+	via := make([]*match, len(chars)+1)
+	for j := 1; j <= len(chars); j++ {
+		best[j] = best[j-1] + charBits
+		via[j] = nil
+		for k := range matches {
+			m := &matches[k]
+			if m.j == j && best[m.i]+m.bits < best[j] {
+				best[j], via[j] = best[m.i]+m.bits, m
+			}
+		}
+	}
+	// the weakest pattern is the one covering most of the password
+	var weakest *match
+	for j := len(chars); j > 0; {
+		m := via[j]
+		if m == nil {
+			j--
+			continue
+		}
+		if weakest == nil || m.j-m.i > weakest.j-weakest.i {
+			weakest = m
+		}
+		j = m.i
+	}
+	if weakest == nil {
+		return best[len(chars)], ""
+	}
+	return best[len(chars)], weakest.pattern
+}
+
+func findMatches(chars []rune, words map[string]int) []match {
+	var matches []match
+	matches = append(matches, dictionaryMatches(chars, words)...)
+	matches = append(matches, repeatMatches(chars, words)...)
+	matches = append(matches, sequenceMatches(chars)...)
+	matches = append(matches, keyboardMatches(chars)...)
+	matches = append(matches, yearMatches(chars)...)
+	return matches
+}
+
+// dictionaryMatches are the words of the password, l33t spoken, reversed or capitalized included
+func dictionaryMatches(chars []rune, words map[string]int) []match {
+	var matches []match
+	for i := 0; i < len(chars); i++ {
+		for j := i + 3; j <= len(chars); j++ {
+			token := chars[i:j]
+			lower := []rune(strings.ToLower(string(token)))
+			bits, ok := wordBits(lower, words)
+			if !ok {
+				continue
+			}
+			matches = append(matches, match{i: i, j: j, bits: bits + uppercaseBits(token), pattern: PatternDictionary})
+		}
+	}
+	return matches
+}
+
+// wordBits are the guesses of a lower case token found in the words, as is, unleeted or reversed
+func wordBits(token []rune, words map[string]int) (float64, bool) {
+	best, found := math.Inf(1), false
+	try := func(w []rune, extra float64) {
+		if rank, ok := words[string(w)]; ok && math.Log2(float64(rank))+extra < best {
+			best, found = math.Log2(float64(rank))+extra, true
+		}
+	}
+	try(token, 0)
+	plain, subs := make([]rune, len(token)), 0
+	for k, c := range token {
+		if p, ok := leet[c]; ok {
+			plain[k], subs = p, subs+1
+		} else {
+			plain[k] = c
+		}
+	}
+	if subs > 0 {
+		try(plain, float64(subs))
+	}
+	reversed := make([]rune, len(token))
+	for k, c := range token {
+		reversed[len(token)-1-k] = c
+	}
+	try(reversed, 1)
+	return math.Max(best, 1), found
+}
+
+// uppercaseBits are the guesses of where the upper case letters of a token are,
+// a capitalized or all upper case token only doubles them
+func uppercaseBits(token []rune) float64 {
+	upper, lower := 0, 0
+	for _, c := range token {
+		switch {
+		case unicode.IsUpper(c):
+			upper++
+		case unicode.IsLower(c):
+			lower++
+		}
+	}
+	if upper == 0 {
+		return 0
+	}
+	if lower == 0 || (upper == 1 && (unicode.IsUpper(token[0]) || unicode.IsUpper(token[len(token)-1]))) {
+		return 1
+	}
+	variations := 0.0
+	for k := 1; k <= upper && k <= lower; k++ {
+		variations += binomial(upper+lower, k)
+	}
+	return math.Log2(variations)
+}
+
+func binomial(n, k int) float64 {
+	r := 1.0
+	for i := 1; i <= k; i++ {
+		r = r * float64(n-k+i) / float64(i)
+	}
+	return r
+}
+
+// repeatMatches are the tokens repeated right after themselves, like aaa or abcabc
+func repeatMatches(chars []rune, words map[string]int) []match {
+	var matches []match
+	for i := 0; i < len(chars); i++ {
+		for size := 1; i+2*size <= len(chars); size++ {
+			base := chars[i : i+size]
+			count := 1
+			for i+(count+1)*size <= len(chars) && string(chars[i+count*size:i+(count+1)*size]) == string(base) {
+				count++
+			}
+			if count < 2 || (size == 1 && count < 3) {
+				continue
+			}
+			baseBits, _ := minimumBits(base, words)
+			matches = append(matches, match{i: i, j: i + count*size, bits: baseBits + math.Log2(float64(count)), pattern: PatternRepeat})
+		}
+	}
+	return matches
+}
+
+// sequenceMatches are the runs of letters or digits going up or down by one, like abc or 987
+func sequenceMatches(chars []rune) []match {
+	var matches []match
+	for i := 0; i+2 < len(chars); {
+		delta := chars[i+1] - chars[i]
+		if (delta != 1 && delta != -1) || charClass(chars[i]) == 0 || charClass(chars[i]) != charClass(chars[i+1]) {
+			i++
+			continue
+		}
+		j := i + 2
+		for j < len(chars) && chars[j]-chars[j-1] == delta && charClass(chars[j]) == charClass(chars[i]) {
+			j++
+		}
+		if j-i >= 3 {
+			matches = append(matches, match{i: i, j: j, bits: sequenceBits(chars[i], j-i, delta < 0), pattern: PatternSequence})
+		}
+		i = j - 1
+	}
+	return matches
+}
+
+func sequenceBits(first rune, length int, descending bool) float64 {
+	starts := 26.0
+	switch {
+	case strings.ContainsRune("aAzZ019", first):
+		starts = 4
+	case unicode.IsDigit(first):
+		starts = 10
+	}
+	if descending {
+		starts *= 2
+	}
+	return math.Log2(starts * float64(length))
+}
+
+// charClass is 1 for digits, 2 for lower case and 3 for upper case ascii letters, 0 for the others
+func charClass(c rune) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return 1
+	case c >= 'a' && c <= 'z':
+		return 2
+	case c >= 'A' && c <= 'Z':
+		return 3
+	}
+	return 0
+}
+
+// keyboardMatches are the runs of neighbour keys of a qwerty row, like qwerty or lkjh
+func keyboardMatches(chars []rune) []match {
+	var matches []match
+	keys := 0
+	for _, row := range keyboardRows {
+		keys += len(row)
+	}
+	lower := []rune(strings.ToLower(string(chars)))
+	for _, row := range keyboardRows {
+		for i := 0; i+2 < len(lower); {
+			a, b := strings.IndexRune(row, lower[i]), strings.IndexRune(row, lower[i+1])
+			step := b - a
+			if a < 0 || b < 0 || (step != 1 && step != -1) {
+				i++
+				continue
+			}
+			j := i + 2
+			for j < len(lower) && strings.IndexRune(row, lower[j])-strings.IndexRune(row, lower[j-1]) == step && strings.IndexRune(row, lower[j]) >= 0 {
+				j++
+			}
+			if j-i >= 3 {
+				bits := math.Log2(float64(keys*2*(j-i))) + uppercaseBits(chars[i:j])
+				matches = append(matches, match{i: i, j: j, bits: bits, pattern: PatternKeyboard})
+			}
+			i = j - 1
+		}
+	}
+	return matches
+}
+
+// yearMatches are the recent years, guessed from the current one outwards
+func yearMatches(chars []rune) []match {
+	var matches []match
+	now := time.Now().Year()
+	for i := 0; i+4 <= len(chars); i++ {
+		year := 0
+		for _, c := range chars[i : i+4] {
+			if c < '0' || c > '9' {
+				year = -1
+				break
+			}
+			year = year*10 + int(c-'0')
+		}
+		if year < 1900 || year > 2099 {
+			continue
+		}
+		space := math.Max(math.Abs(float64(year-now)), 20)
+		matches = append(matches, match{i: i, j: i + 4, bits: math.Log2(space), pattern: PatternYear})
+	}
+	return matches
+}
+
+// cardinality is the size of the character classes the password draws from
+func cardinality(chars []rune) int {
+	var digits, lower, upper, symbols, other bool
+	for _, c := range chars {
+		switch {
+		case c >= '0' && c <= '9':
+			digits = true
+		case c >= 'a' && c <= 'z':
+			lower = true
+		case c >= 'A' && c <= 'Z':
+			upper = true
+		case c < 0x80:
+			symbols = true
+		default:
+			other = true
+		}
+	}
+	n := 0
+	for _, class := range []struct {
+		in   bool
+		size int
+	}{{digits, 10}, {lower, 26}, {upper, 26}, {symbols, 33}, {other, 100}} {
+		if class.in {
+			n += class.size
+		}
+	}
+	if n == 0 {
+		n = 10
+	}
+	return n
+}
@@ -23,19 +23,53 @@ const (
 type ReturnCode int32
 
 const (
-	ReturnCode_SUCCESS ReturnCode = 0
-	ReturnCode_ERROR   ReturnCode = 1
+	ReturnCode_SUCCESS             ReturnCode = 0
+	ReturnCode_ERROR               ReturnCode = 1
+	ReturnCode_INVALID_CREDENTIALS ReturnCode = 2
+	ReturnCode_NOT_FOUND           ReturnCode = 3
+	// the wallet has guardians and no approved recovery case was given
+	ReturnCode_APPROVAL_REQUIRED ReturnCode = 4
+	// a sign policy rejected the request
+	ReturnCode_POLICY_DENIED ReturnCode = 5
+	// too many wrong credentials were given, the wallet is locked for a while
+	ReturnCode_LOCKED ReturnCode = 6
+	// the wallet is frozen, its keys can not be retrieved
+	ReturnCode_FROZEN ReturnCode = 7
+	// the wallet has an owner address and no valid session token of a login was given
+	ReturnCode_SESSION_REQUIRED ReturnCode = 8
+	// the wallet has totp enabled and no totp code was given
+	ReturnCode_TOTP_REQUIRED ReturnCode = 9
+	// the new credentials break the credential policy or are too old to store keys, see the violations
+	ReturnCode_CREDENTIAL_POLICY ReturnCode = 10
 )
 
 // Enum value maps for ReturnCode.
 var (
 	ReturnCode_name = map[int32]string{
-		0: "SUCCESS",
-		1: "ERROR",
+		0:  "SUCCESS",
+		1:  "ERROR",
+		2:  "INVALID_CREDENTIALS",
+		3:  "NOT_FOUND",
+		4:  "APPROVAL_REQUIRED",
+		5:  "POLICY_DENIED",
+		6:  "LOCKED",
+		7:  "FROZEN",
+		8:  "SESSION_REQUIRED",
+		9:  "TOTP_REQUIRED",
+		10: "CREDENTIAL_POLICY",
 	}
 	ReturnCode_value = map[string]int32{
-		"SUCCESS": 0,
-		"ERROR":   1,
+		"SUCCESS":             0,
+		"ERROR":               1,
+		"INVALID_CREDENTIALS": 2,
+		"NOT_FOUND":           3,
+		"APPROVAL_REQUIRED":   4,
+		"POLICY_DENIED":       5,
+		"LOCKED":              6,
+		"FROZEN":              7,
+		"SESSION_REQUIRED":    8,
+		"TOTP_REQUIRED":       9,
+		"CREDENTIAL_POLICY":   10,
 	}
 )
 
@@ -71,8 +105,10 @@ type SocialKey struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key     string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Label   string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Version uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *SocialKey) Reset() {
@@ -121,6 +157,20 @@ func (x *SocialKey) GetKey() string {
 	return ""
 }
 
+func (x *SocialKey) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SocialKey) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type SupportChainReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -252,12 +302,17 @@ type SetSocialKeyReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
-	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
-	WalletUuid    string `protobuf:"bytes,3,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
-	Key           string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
-	Password      string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
-	SocialCode    string `protobuf:"bytes,6,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	ConsumerToken  string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain          string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	WalletUuid     string `protobuf:"bytes,3,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Key            string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Password       string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode     string `protobuf:"bytes,6,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// key_id stores a new version of the key, without it a new key is created
+	// unless the wallet already has a key with the label
+	KeyId string `protobuf:"bytes,8,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Label string `protobuf:"bytes,9,opt,name=label,proto3" json:"label,omitempty"`
 }
 
 func (x *SetSocialKeyReq) Reset() {
@@ -334,18 +389,44 @@ func (x *SetSocialKeyReq) GetSocialCode() string {
 	return ""
 }
 
+func (x *SetSocialKeyReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *SetSocialKeyReq) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *SetSocialKeyReq) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
 type SetSocialKeyRep struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code      ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
-	Msg       string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Pub       string     `protobuf:"bytes,3,opt,name=pub,proto3" json:"pub,omitempty"`
-	Priv      string     `protobuf:"bytes,4,opt,name=priv,proto3" json:"priv,omitempty"`
-	CryptoWay string     `protobuf:"bytes,5,opt,name=crypto_way,json=cryptoWay,proto3" json:"crypto_way,omitempty"`
-	FileCid   string     `protobuf:"bytes,6,opt,name=file_cid,json=fileCid,proto3" json:"file_cid,omitempty"`
-	Contract  string     `protobuf:"bytes,7,opt,name=contract,proto3" json:"contract,omitempty"`
+	Code       ReturnCode         `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg        string             `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Pub        string             `protobuf:"bytes,3,opt,name=pub,proto3" json:"pub,omitempty"`
+	Priv       string             `protobuf:"bytes,4,opt,name=priv,proto3" json:"priv,omitempty"`
+	CryptoWay  string             `protobuf:"bytes,5,opt,name=crypto_way,json=cryptoWay,proto3" json:"crypto_way,omitempty"`
+	FileCid    string             `protobuf:"bytes,6,opt,name=file_cid,json=fileCid,proto3" json:"file_cid,omitempty"`
+	Contract   string             `protobuf:"bytes,7,opt,name=contract,proto3" json:"contract,omitempty"`
+	JobId      string             `protobuf:"bytes,8,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	TxHash     string             `protobuf:"bytes,9,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	KeyId      string             `protobuf:"bytes,10,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Version    uint64             `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	Violations []*PolicyViolation `protobuf:"bytes,12,rep,name=violations,proto3" json:"violations,omitempty"`
 }
 
 func (x *SetSocialKeyRep) Reset() {
@@ -429,6 +510,41 @@ func (x *SetSocialKeyRep) GetContract() string {
 	return ""
 }
 
+func (x *SetSocialKeyRep) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *SetSocialKeyRep) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *SetSocialKeyRep) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *SetSocialKeyRep) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SetSocialKeyRep) GetViolations() []*PolicyViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
 type GetSocialKeyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -438,6 +554,17 @@ type GetSocialKeyReq struct {
 	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
 	WalletUuid    string `protobuf:"bytes,3,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
 	FileCid       string `protobuf:"bytes,4,opt,name=file_cid,json=fileCid,proto3" json:"file_cid,omitempty"`
+	// without key_id the latest version of every key is returned, version 0 selects the latest
+	KeyId   string `protobuf:"bytes,5,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Version uint64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// required when the wallet has guardians, see requestRecovery
+	RecoveryCaseId string `protobuf:"bytes,7,opt,name=recovery_case_id,json=recoveryCaseId,proto3" json:"recovery_case_id,omitempty"`
+	// issued by login, required when the wallet has an owner address
+	SessionToken string `protobuf:"bytes,8,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	// the current code of the authenticator app or a backup code, required once totp is enabled
+	TotpCode string `protobuf:"bytes,9,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	// a recovery code, replaces the totp_code and is burnt, the session_token is still required
+	RecoveryCode string `protobuf:"bytes,10,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
 }
 
 func (x *GetSocialKeyReq) Reset() {
@@ -500,6 +627,48 @@ func (x *GetSocialKeyReq) GetFileCid() string {
 	return ""
 }
 
+func (x *GetSocialKeyReq) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *GetSocialKeyReq) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetSocialKeyReq) GetRecoveryCaseId() string {
+	if x != nil {
+		return x.RecoveryCaseId
+	}
+	return ""
+}
+
+func (x *GetSocialKeyReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *GetSocialKeyReq) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+func (x *GetSocialKeyReq) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
 type GetSocialKeyRep struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -563,222 +732,10544 @@ func (x *GetSocialKeyRep) GetKeyList() []*SocialKey {
 	return nil
 }
 
-var File_proto_keylocker_proto protoreflect.FileDescriptor
+// RecoverSocialKeyReq selects the keys like GetSocialKeyReq, password and social_code are encrypted
+// like in SetSocialKeyReq. The keys are returned decrypted.
+type RecoverSocialKeyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_proto_keylocker_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
-	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x22, 0x2d, 0x0a, 0x09,
-	0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x68, 0x0a, 0x0f, 0x53,
-	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x25,
-	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0x72, 0x0a, 0x0f, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
-	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xbe, 0x01, 0x0a, 0x0f, 0x53, 0x65,
-	0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a,
-	0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63,
-	0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x0f, 0x53,
-	0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x12, 0x33,
-	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73,
-	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
-	0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x75, 0x62, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x70, 0x75, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x69, 0x76, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x72, 0x69, 0x76, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f, 0x77, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x57, 0x61, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69,
-	0x6c, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69,
-	0x6c, 0x65, 0x43, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x22, 0x8a, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b,
-	0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
-	0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
-	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55,
-	0x75, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x64, 0x22, 0x93,
-	0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52,
-	0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79,
-	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x39, 0x0a, 0x08, 0x6b, 0x65, 0x79,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x61,
-	0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
-	0x72, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x07, 0x6b, 0x65, 0x79,
-	0x4c, 0x69, 0x73, 0x74, 0x2a, 0x24, 0x0a, 0x0a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12,
-	0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x32, 0xaf, 0x02, 0x0a, 0x10, 0x4c,
-	0x65, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x5f, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x12, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b,
-	0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75,
-	0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53,
-	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x22, 0x00,
-	0x12, 0x5c, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79,
-	0x12, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79,
-	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
-	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74,
-	0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x5c,
-	0x0a, 0x0c, 0x67, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x24,
-	0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f,
-	0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65,
-	0x79, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63,
-	0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f,
-	0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x22, 0x00, 0x42, 0x2b, 0x0a, 0x16,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x2e, 0x6b, 0x65, 0x79,
-	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x5a, 0x11, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	WalletUuid    string `protobuf:"bytes,3,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,5,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	FileCid       string `protobuf:"bytes,6,opt,name=file_cid,json=fileCid,proto3" json:"file_cid,omitempty"`
+	KeyId         string `protobuf:"bytes,7,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Version       uint64 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	// required when the wallet has guardians, see requestRecovery
+	RecoveryCaseId string `protobuf:"bytes,9,opt,name=recovery_case_id,json=recoveryCaseId,proto3" json:"recovery_case_id,omitempty"`
+	// issued by login, required when the wallet has an owner address
+	SessionToken string `protobuf:"bytes,10,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	// the current code of the authenticator app or a backup code, required once totp is enabled
+	TotpCode string `protobuf:"bytes,11,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	// a recovery code, replaces the totp_code and is burnt, the session_token is still required
+	RecoveryCode string `protobuf:"bytes,12,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
 }
 
-var (
-	file_proto_keylocker_proto_rawDescOnce sync.Once
-	file_proto_keylocker_proto_rawDescData = file_proto_keylocker_proto_rawDesc
-)
+func (x *RecoverSocialKeyReq) Reset() {
+	*x = RecoverSocialKeyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_proto_keylocker_proto_rawDescGZIP() []byte {
-	file_proto_keylocker_proto_rawDescOnce.Do(func() {
-		file_proto_keylocker_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_keylocker_proto_rawDescData)
-	})
-	return file_proto_keylocker_proto_rawDescData
+func (x *RecoverSocialKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_proto_keylocker_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_keylocker_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-var file_proto_keylocker_proto_goTypes = []interface{}{
-	(ReturnCode)(0),         // 0: savourrpc.keylocker.ReturnCode
-	(*SocialKey)(nil),       // 1: savourrpc.keylocker.SocialKey
-	(*SupportChainReq)(nil), // 2: savourrpc.keylocker.SupportChainReq
-	(*SupportChainRep)(nil), // 3: savourrpc.keylocker.SupportChainRep
-	(*SetSocialKeyReq)(nil), // 4: savourrpc.keylocker.SetSocialKeyReq
-	(*SetSocialKeyRep)(nil), // 5: savourrpc.keylocker.SetSocialKeyRep
-	(*GetSocialKeyReq)(nil), // 6: savourrpc.keylocker.GetSocialKeyReq
-	(*GetSocialKeyRep)(nil), // 7: savourrpc.keylocker.GetSocialKeyRep
+func (*RecoverSocialKeyReq) ProtoMessage() {}
+
+func (x *RecoverSocialKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_proto_keylocker_proto_depIdxs = []int32{
-	0, // 0: savourrpc.keylocker.SupportChainRep.code:type_name -> savourrpc.keylocker.ReturnCode
-	0, // 1: savourrpc.keylocker.SetSocialKeyRep.code:type_name -> savourrpc.keylocker.ReturnCode
-	0, // 2: savourrpc.keylocker.GetSocialKeyRep.code:type_name -> savourrpc.keylocker.ReturnCode
-	1, // 3: savourrpc.keylocker.GetSocialKeyRep.key_list:type_name -> savourrpc.keylocker.SocialKey
-	2, // 4: savourrpc.keylocker.LeyLockerService.getSupportChain:input_type -> savourrpc.keylocker.SupportChainReq
-	4, // 5: savourrpc.keylocker.LeyLockerService.setSocialKey:input_type -> savourrpc.keylocker.SetSocialKeyReq
-	6, // 6: savourrpc.keylocker.LeyLockerService.getSocialKey:input_type -> savourrpc.keylocker.GetSocialKeyReq
-	3, // 7: savourrpc.keylocker.LeyLockerService.getSupportChain:output_type -> savourrpc.keylocker.SupportChainRep
-	5, // 8: savourrpc.keylocker.LeyLockerService.setSocialKey:output_type -> savourrpc.keylocker.SetSocialKeyRep
-	7, // 9: savourrpc.keylocker.LeyLockerService.getSocialKey:output_type -> savourrpc.keylocker.GetSocialKeyRep
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+
+// Deprecated: Use RecoverSocialKeyReq.ProtoReflect.Descriptor instead.
+func (*RecoverSocialKeyReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{7}
 }
 
-func init() { file_proto_keylocker_proto_init() }
-func file_proto_keylocker_proto_init() {
-	if File_proto_keylocker_proto != nil {
-		return
+func (x *RecoverSocialKeyReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_keylocker_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SocialKey); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_keylocker_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SupportChainReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_keylocker_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SupportChainRep); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_keylocker_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetSocialKeyReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_keylocker_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetSocialKeyRep); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_keylocker_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSocialKeyReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_keylocker_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSocialKeyRep); i {
+	return ""
+}
+
+func (x *RecoverSocialKeyReq) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *RecoverSocialKeyReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *RecoverSocialKeyReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RecoverSocialKeyReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *RecoverSocialKeyReq) GetFileCid() string {
+	if x != nil {
+		return x.FileCid
+	}
+	return ""
+}
+
+func (x *RecoverSocialKeyReq) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *RecoverSocialKeyReq) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RecoverSocialKeyReq) GetRecoveryCaseId() string {
+	if x != nil {
+		return x.RecoveryCaseId
+	}
+	return ""
+}
+
+func (x *RecoverSocialKeyReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *RecoverSocialKeyReq) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+func (x *RecoverSocialKeyReq) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+type RecoverSocialKeyRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    ReturnCode   `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg     string       `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	KeyList []*SocialKey `protobuf:"bytes,3,rep,name=key_list,json=keyList,proto3" json:"key_list,omitempty"`
+}
+
+func (x *RecoverSocialKeyRep) Reset() {
+	*x = RecoverSocialKeyRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoverSocialKeyRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverSocialKeyRep) ProtoMessage() {}
+
+func (x *RecoverSocialKeyRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverSocialKeyRep.ProtoReflect.Descriptor instead.
+func (*RecoverSocialKeyRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RecoverSocialKeyRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *RecoverSocialKeyRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RecoverSocialKeyRep) GetKeyList() []*SocialKey {
+	if x != nil {
+		return x.KeyList
+	}
+	return nil
+}
+
+// job_ids and tx_hashes are set by the contract backends, file_cids lists the unpinned files on ipfs
+type DeleteSocialKeyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	WalletUuid    string `protobuf:"bytes,3,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Password      string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,6,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+}
+
+func (x *DeleteSocialKeyReq) Reset() {
+	*x = DeleteSocialKeyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSocialKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSocialKeyReq) ProtoMessage() {}
+
+func (x *DeleteSocialKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSocialKeyReq.ProtoReflect.Descriptor instead.
+func (*DeleteSocialKeyReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteSocialKeyReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *DeleteSocialKeyReq) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *DeleteSocialKeyReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *DeleteSocialKeyReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeleteSocialKeyReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DeleteSocialKeyReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+type DeleteSocialKeyRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg      string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	JobIds   []string   `protobuf:"bytes,3,rep,name=job_ids,json=jobIds,proto3" json:"job_ids,omitempty"`
+	TxHashes []string   `protobuf:"bytes,4,rep,name=tx_hashes,json=txHashes,proto3" json:"tx_hashes,omitempty"`
+	FileCids []string   `protobuf:"bytes,5,rep,name=file_cids,json=fileCids,proto3" json:"file_cids,omitempty"`
+}
+
+func (x *DeleteSocialKeyRep) Reset() {
+	*x = DeleteSocialKeyRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSocialKeyRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSocialKeyRep) ProtoMessage() {}
+
+func (x *DeleteSocialKeyRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSocialKeyRep.ProtoReflect.Descriptor instead.
+func (*DeleteSocialKeyRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteSocialKeyRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *DeleteSocialKeyRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *DeleteSocialKeyRep) GetJobIds() []string {
+	if x != nil {
+		return x.JobIds
+	}
+	return nil
+}
+
+func (x *DeleteSocialKeyRep) GetTxHashes() []string {
+	if x != nil {
+		return x.TxHashes
+	}
+	return nil
+}
+
+func (x *DeleteSocialKeyRep) GetFileCids() []string {
+	if x != nil {
+		return x.FileCids
+	}
+	return nil
+}
+
+// PruneSocialKeyReq removes the versions of the key older than before_version,
+// 0 keeps only the latest version
+type PruneSocialKeyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	WalletUuid    string `protobuf:"bytes,3,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	KeyId         string `protobuf:"bytes,4,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	BeforeVersion uint64 `protobuf:"varint,5,opt,name=before_version,json=beforeVersion,proto3" json:"before_version,omitempty"`
+	Password      string `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,7,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+}
+
+func (x *PruneSocialKeyReq) Reset() {
+	*x = PruneSocialKeyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneSocialKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneSocialKeyReq) ProtoMessage() {}
+
+func (x *PruneSocialKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneSocialKeyReq.ProtoReflect.Descriptor instead.
+func (*PruneSocialKeyReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PruneSocialKeyReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *PruneSocialKeyReq) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *PruneSocialKeyReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *PruneSocialKeyReq) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *PruneSocialKeyReq) GetBeforeVersion() uint64 {
+	if x != nil {
+		return x.BeforeVersion
+	}
+	return 0
+}
+
+func (x *PruneSocialKeyReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *PruneSocialKeyReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+type PruneSocialKeyRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code           ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg            string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	PrunedVersions []uint64   `protobuf:"varint,3,rep,packed,name=pruned_versions,json=prunedVersions,proto3" json:"pruned_versions,omitempty"`
+	JobIds         []string   `protobuf:"bytes,4,rep,name=job_ids,json=jobIds,proto3" json:"job_ids,omitempty"`
+	TxHashes       []string   `protobuf:"bytes,5,rep,name=tx_hashes,json=txHashes,proto3" json:"tx_hashes,omitempty"`
+	FileCids       []string   `protobuf:"bytes,6,rep,name=file_cids,json=fileCids,proto3" json:"file_cids,omitempty"`
+}
+
+func (x *PruneSocialKeyRep) Reset() {
+	*x = PruneSocialKeyRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneSocialKeyRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneSocialKeyRep) ProtoMessage() {}
+
+func (x *PruneSocialKeyRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneSocialKeyRep.ProtoReflect.Descriptor instead.
+func (*PruneSocialKeyRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PruneSocialKeyRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *PruneSocialKeyRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *PruneSocialKeyRep) GetPrunedVersions() []uint64 {
+	if x != nil {
+		return x.PrunedVersions
+	}
+	return nil
+}
+
+func (x *PruneSocialKeyRep) GetJobIds() []string {
+	if x != nil {
+		return x.JobIds
+	}
+	return nil
+}
+
+func (x *PruneSocialKeyRep) GetTxHashes() []string {
+	if x != nil {
+		return x.TxHashes
+	}
+	return nil
+}
+
+func (x *PruneSocialKeyRep) GetFileCids() []string {
+	if x != nil {
+		return x.FileCids
+	}
+	return nil
+}
+
+// status is the job status of the transaction on the contract backends and stored on the others
+type SocialKeyMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Chain                 string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	FileCid               string `protobuf:"bytes,3,opt,name=file_cid,json=fileCid,proto3" json:"file_cid,omitempty"`
+	TxHash                string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	CreatedAt             int64  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Scheme                string `protobuf:"bytes,6,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Status                string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Confirmations         uint64 `protobuf:"varint,8,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	RequiredConfirmations uint64 `protobuf:"varint,9,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	Label                 string `protobuf:"bytes,10,opt,name=label,proto3" json:"label,omitempty"`
+	Version               uint64 `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	// the wallet the key was transferred to
+	SupersededBy string `protobuf:"bytes,12,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
+}
+
+func (x *SocialKeyMeta) Reset() {
+	*x = SocialKeyMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SocialKeyMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SocialKeyMeta) ProtoMessage() {}
+
+func (x *SocialKeyMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SocialKeyMeta.ProtoReflect.Descriptor instead.
+func (*SocialKeyMeta) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SocialKeyMeta) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SocialKeyMeta) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *SocialKeyMeta) GetFileCid() string {
+	if x != nil {
+		return x.FileCid
+	}
+	return ""
+}
+
+func (x *SocialKeyMeta) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *SocialKeyMeta) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SocialKeyMeta) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *SocialKeyMeta) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SocialKeyMeta) GetConfirmations() uint64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *SocialKeyMeta) GetRequiredConfirmations() uint64 {
+	if x != nil {
+		return x.RequiredConfirmations
+	}
+	return 0
+}
+
+func (x *SocialKeyMeta) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SocialKeyMeta) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SocialKeyMeta) GetSupersededBy() string {
+	if x != nil {
+		return x.SupersededBy
+	}
+	return ""
+}
+
+// an empty chain lists the keys of all chains
+type ListSocialKeysReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	WalletUuid    string `protobuf:"bytes,3,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+}
+
+func (x *ListSocialKeysReq) Reset() {
+	*x = ListSocialKeysReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSocialKeysReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSocialKeysReq) ProtoMessage() {}
+
+func (x *ListSocialKeysReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSocialKeysReq.ProtoReflect.Descriptor instead.
+func (*ListSocialKeysReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListSocialKeysReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *ListSocialKeysReq) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ListSocialKeysReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+type ListSocialKeysRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code ReturnCode       `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg  string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Keys []*SocialKeyMeta `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *ListSocialKeysRep) Reset() {
+	*x = ListSocialKeysRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSocialKeysRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSocialKeysRep) ProtoMessage() {}
+
+func (x *ListSocialKeysRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSocialKeysRep.ProtoReflect.Descriptor instead.
+func (*ListSocialKeysRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListSocialKeysRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *ListSocialKeysRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListSocialKeysRep) GetKeys() []*SocialKeyMeta {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type ReloadConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+}
+
+func (x *ReloadConfigReq) Reset() {
+	*x = ReloadConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigReq) ProtoMessage() {}
+
+func (x *ReloadConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigReq.ProtoReflect.Descriptor instead.
+func (*ReloadConfigReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReloadConfigReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+type ReloadConfigRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code          ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg           string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	RebuiltChains []string   `protobuf:"bytes,3,rep,name=rebuilt_chains,json=rebuiltChains,proto3" json:"rebuilt_chains,omitempty"`
+}
+
+func (x *ReloadConfigRep) Reset() {
+	*x = ReloadConfigRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadConfigRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigRep) ProtoMessage() {}
+
+func (x *ReloadConfigRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigRep.ProtoReflect.Descriptor instead.
+func (*ReloadConfigRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReloadConfigRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *ReloadConfigRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ReloadConfigRep) GetRebuiltChains() []string {
+	if x != nil {
+		return x.RebuiltChains
+	}
+	return nil
+}
+
+type PluginDescribeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PluginDescribeReq) Reset() {
+	*x = PluginDescribeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginDescribeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginDescribeReq) ProtoMessage() {}
+
+func (x *PluginDescribeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginDescribeReq.ProtoReflect.Descriptor instead.
+func (*PluginDescribeReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{18}
+}
+
+type PluginDescribeRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg   string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Chain string     `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
+}
+
+func (x *PluginDescribeRep) Reset() {
+	*x = PluginDescribeRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginDescribeRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginDescribeRep) ProtoMessage() {}
+
+func (x *PluginDescribeRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginDescribeRep.ProtoReflect.Descriptor instead.
+func (*PluginDescribeRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PluginDescribeRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *PluginDescribeRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *PluginDescribeRep) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+type ImportSocialKeysReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImportId      string           `protobuf:"bytes,1,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	Seq           uint64           `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Item          *SetSocialKeyReq `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	ConsumerToken string           `protobuf:"bytes,4,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+}
+
+func (x *ImportSocialKeysReq) Reset() {
+	*x = ImportSocialKeysReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSocialKeysReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSocialKeysReq) ProtoMessage() {}
+
+func (x *ImportSocialKeysReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSocialKeysReq.ProtoReflect.Descriptor instead.
+func (*ImportSocialKeysReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ImportSocialKeysReq) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+func (x *ImportSocialKeysReq) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ImportSocialKeysReq) GetItem() *SetSocialKeyReq {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *ImportSocialKeysReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+type ImportSocialKeyResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq     uint64     `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Code    ReturnCode `protobuf:"varint,2,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg     string     `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	FileCid string     `protobuf:"bytes,4,opt,name=file_cid,json=fileCid,proto3" json:"file_cid,omitempty"`
+}
+
+func (x *ImportSocialKeyResult) Reset() {
+	*x = ImportSocialKeyResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSocialKeyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSocialKeyResult) ProtoMessage() {}
+
+func (x *ImportSocialKeyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSocialKeyResult.ProtoReflect.Descriptor instead.
+func (*ImportSocialKeyResult) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportSocialKeyResult) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ImportSocialKeyResult) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *ImportSocialKeyResult) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ImportSocialKeyResult) GetFileCid() string {
+	if x != nil {
+		return x.FileCid
+	}
+	return ""
+}
+
+type ImportSocialKeysRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg        string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Checkpoint uint64     `protobuf:"varint,3,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	// results holds the failed items only, up to the first 100 of them
+	Results  []*ImportSocialKeyResult `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	Imported uint64                   `protobuf:"varint,5,opt,name=imported,proto3" json:"imported,omitempty"`
+	Failed   uint64                   `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *ImportSocialKeysRep) Reset() {
+	*x = ImportSocialKeysRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSocialKeysRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSocialKeysRep) ProtoMessage() {}
+
+func (x *ImportSocialKeysRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSocialKeysRep.ProtoReflect.Descriptor instead.
+func (*ImportSocialKeysRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImportSocialKeysRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *ImportSocialKeysRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ImportSocialKeysRep) GetCheckpoint() uint64 {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return 0
+}
+
+func (x *ImportSocialKeysRep) GetResults() []*ImportSocialKeyResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportSocialKeysRep) GetImported() uint64 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportSocialKeysRep) GetFailed() uint64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type GetImportCheckpointReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	ImportId      string `protobuf:"bytes,2,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+}
+
+func (x *GetImportCheckpointReq) Reset() {
+	*x = GetImportCheckpointReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetImportCheckpointReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImportCheckpointReq) ProtoMessage() {}
+
+func (x *GetImportCheckpointReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImportCheckpointReq.ProtoReflect.Descriptor instead.
+func (*GetImportCheckpointReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetImportCheckpointReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *GetImportCheckpointReq) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+type GetImportCheckpointRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg        string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Checkpoint uint64     `protobuf:"varint,3,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+}
+
+func (x *GetImportCheckpointRep) Reset() {
+	*x = GetImportCheckpointRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetImportCheckpointRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImportCheckpointRep) ProtoMessage() {}
+
+func (x *GetImportCheckpointRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImportCheckpointRep.ProtoReflect.Descriptor instead.
+func (*GetImportCheckpointRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetImportCheckpointRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *GetImportCheckpointRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetImportCheckpointRep) GetCheckpoint() uint64 {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return 0
+}
+
+type ExportSocialKeysReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	AfterCursor   uint64 `protobuf:"varint,3,opt,name=after_cursor,json=afterCursor,proto3" json:"after_cursor,omitempty"`
+	Limit         uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ExportSocialKeysReq) Reset() {
+	*x = ExportSocialKeysReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportSocialKeysReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSocialKeysReq) ProtoMessage() {}
+
+func (x *ExportSocialKeysReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSocialKeysReq.ProtoReflect.Descriptor instead.
+func (*ExportSocialKeysReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExportSocialKeysReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *ExportSocialKeysReq) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ExportSocialKeysReq) GetAfterCursor() uint64 {
+	if x != nil {
+		return x.AfterCursor
+	}
+	return 0
+}
+
+func (x *ExportSocialKeysReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ExportSocialKeysRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       ReturnCode   `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg        string       `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Cursor     uint64       `protobuf:"varint,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	WalletUuid string       `protobuf:"bytes,4,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Pub        string       `protobuf:"bytes,5,opt,name=pub,proto3" json:"pub,omitempty"`
+	Priv       string       `protobuf:"bytes,6,opt,name=priv,proto3" json:"priv,omitempty"`
+	KeyList    []*SocialKey `protobuf:"bytes,7,rep,name=key_list,json=keyList,proto3" json:"key_list,omitempty"`
+}
+
+func (x *ExportSocialKeysRep) Reset() {
+	*x = ExportSocialKeysRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportSocialKeysRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSocialKeysRep) ProtoMessage() {}
+
+func (x *ExportSocialKeysRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSocialKeysRep.ProtoReflect.Descriptor instead.
+func (*ExportSocialKeysRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExportSocialKeysRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *ExportSocialKeysRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ExportSocialKeysRep) GetCursor() uint64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ExportSocialKeysRep) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *ExportSocialKeysRep) GetPub() string {
+	if x != nil {
+		return x.Pub
+	}
+	return ""
+}
+
+func (x *ExportSocialKeysRep) GetPriv() string {
+	if x != nil {
+		return x.Priv
+	}
+	return ""
+}
+
+func (x *ExportSocialKeysRep) GetKeyList() []*SocialKey {
+	if x != nil {
+		return x.KeyList
+	}
+	return nil
+}
+
+// the job is read with the admin token as consumer_token, or with the credentials of its wallet
+type GetJobStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	JobId         string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	WalletUuid    string `protobuf:"bytes,3,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,5,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+}
+
+func (x *GetJobStatusReq) Reset() {
+	*x = GetJobStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobStatusReq) ProtoMessage() {}
+
+func (x *GetJobStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobStatusReq.ProtoReflect.Descriptor instead.
+func (*GetJobStatusReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetJobStatusReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *GetJobStatusReq) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *GetJobStatusReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *GetJobStatusReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GetJobStatusReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+// status is one of pending, mined, confirmed, reverted or dropped
+type GetJobStatusRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code                  ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg                   string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	JobId                 string     `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Chain                 string     `protobuf:"bytes,4,opt,name=chain,proto3" json:"chain,omitempty"`
+	WalletUuid            string     `protobuf:"bytes,5,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	TxHash                string     `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Status                string     `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	BlockNumber           uint64     `protobuf:"varint,8,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Confirmations         uint64     `protobuf:"varint,9,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	RequiredConfirmations uint64     `protobuf:"varint,10,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+}
+
+func (x *GetJobStatusRep) Reset() {
+	*x = GetJobStatusRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobStatusRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobStatusRep) ProtoMessage() {}
+
+func (x *GetJobStatusRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobStatusRep.ProtoReflect.Descriptor instead.
+func (*GetJobStatusRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetJobStatusRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *GetJobStatusRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetJobStatusRep) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *GetJobStatusRep) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *GetJobStatusRep) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *GetJobStatusRep) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *GetJobStatusRep) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetJobStatusRep) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *GetJobStatusRep) GetConfirmations() uint64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *GetJobStatusRep) GetRequiredConfirmations() uint64 {
+	if x != nil {
+		return x.RequiredConfirmations
+	}
+	return 0
+}
+
+// the guardians are replaced, an empty list removes them. password and social_code unlock the wallet
+// like in recoverSocialKey
+type SetGuardiansReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string   `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string   `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string   `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	Guardians     []string `protobuf:"bytes,5,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	Threshold     uint32   `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *SetGuardiansReq) Reset() {
+	*x = SetGuardiansReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGuardiansReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGuardiansReq) ProtoMessage() {}
+
+func (x *SetGuardiansReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGuardiansReq.ProtoReflect.Descriptor instead.
+func (*SetGuardiansReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetGuardiansReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *SetGuardiansReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *SetGuardiansReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SetGuardiansReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *SetGuardiansReq) GetGuardians() []string {
+	if x != nil {
+		return x.Guardians
+	}
+	return nil
+}
+
+func (x *SetGuardiansReq) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type SetGuardiansRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// set when the wallet has guardians, the change then applies at pending_at unless the wallet is frozen before
+	PendingAt int64 `protobuf:"varint,3,opt,name=pending_at,json=pendingAt,proto3" json:"pending_at,omitempty"`
+}
+
+func (x *SetGuardiansRep) Reset() {
+	*x = SetGuardiansRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGuardiansRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGuardiansRep) ProtoMessage() {}
+
+func (x *SetGuardiansRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGuardiansRep.ProtoReflect.Descriptor instead.
+func (*SetGuardiansRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetGuardiansRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *SetGuardiansRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SetGuardiansRep) GetPendingAt() int64 {
+	if x != nil {
+		return x.PendingAt
+	}
+	return 0
+}
+
+type GetGuardiansReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+}
+
+func (x *GetGuardiansReq) Reset() {
+	*x = GetGuardiansReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGuardiansReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuardiansReq) ProtoMessage() {}
+
+func (x *GetGuardiansReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuardiansReq.ProtoReflect.Descriptor instead.
+func (*GetGuardiansReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetGuardiansReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *GetGuardiansReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+type GetGuardiansRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg       string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Guardians []string   `protobuf:"bytes,3,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	Threshold uint32     `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// the change waiting for its delay, see setGuardians
+	PendingGuardians []string `protobuf:"bytes,5,rep,name=pending_guardians,json=pendingGuardians,proto3" json:"pending_guardians,omitempty"`
+	PendingThreshold uint32   `protobuf:"varint,6,opt,name=pending_threshold,json=pendingThreshold,proto3" json:"pending_threshold,omitempty"`
+	PendingAt        int64    `protobuf:"varint,7,opt,name=pending_at,json=pendingAt,proto3" json:"pending_at,omitempty"`
+}
+
+func (x *GetGuardiansRep) Reset() {
+	*x = GetGuardiansRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGuardiansRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuardiansRep) ProtoMessage() {}
+
+func (x *GetGuardiansRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuardiansRep.ProtoReflect.Descriptor instead.
+func (*GetGuardiansRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetGuardiansRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *GetGuardiansRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetGuardiansRep) GetGuardians() []string {
+	if x != nil {
+		return x.Guardians
+	}
+	return nil
+}
+
+func (x *GetGuardiansRep) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *GetGuardiansRep) GetPendingGuardians() []string {
+	if x != nil {
+		return x.PendingGuardians
+	}
+	return nil
+}
+
+func (x *GetGuardiansRep) GetPendingThreshold() uint32 {
+	if x != nil {
+		return x.PendingThreshold
+	}
+	return 0
+}
+
+func (x *GetGuardiansRep) GetPendingAt() int64 {
+	if x != nil {
+		return x.PendingAt
+	}
+	return 0
+}
+
+type RequestRecoveryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	WalletUuid    string `protobuf:"bytes,3,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+}
+
+func (x *RequestRecoveryReq) Reset() {
+	*x = RequestRecoveryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestRecoveryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRecoveryReq) ProtoMessage() {}
+
+func (x *RequestRecoveryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRecoveryReq.ProtoReflect.Descriptor instead.
+func (*RequestRecoveryReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RequestRecoveryReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *RequestRecoveryReq) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *RequestRecoveryReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+// typed_data is the EIP-712 message the guardians sign with eth_signTypedData_v4, digest is its hash.
+// status is open until the guardians approved the case, then pending until it is released or cancelled,
+// a pending case can be released from release_at
+type RecoveryCase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CaseId     string   `protobuf:"bytes,1,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	Chain      string   `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	WalletUuid string   `protobuf:"bytes,3,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Status     string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ExpiresAt  int64    `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Threshold  uint32   `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Approvals  []string `protobuf:"bytes,7,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	TypedData  string   `protobuf:"bytes,8,opt,name=typed_data,json=typedData,proto3" json:"typed_data,omitempty"`
+	Digest     string   `protobuf:"bytes,9,opt,name=digest,proto3" json:"digest,omitempty"`
+	ReleaseAt  int64    `protobuf:"varint,10,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`
+}
+
+func (x *RecoveryCase) Reset() {
+	*x = RecoveryCase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryCase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryCase) ProtoMessage() {}
+
+func (x *RecoveryCase) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryCase.ProtoReflect.Descriptor instead.
+func (*RecoveryCase) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RecoveryCase) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+func (x *RecoveryCase) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *RecoveryCase) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *RecoveryCase) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RecoveryCase) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *RecoveryCase) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *RecoveryCase) GetApprovals() []string {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *RecoveryCase) GetTypedData() string {
+	if x != nil {
+		return x.TypedData
+	}
+	return ""
+}
+
+func (x *RecoveryCase) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *RecoveryCase) GetReleaseAt() int64 {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return 0
+}
+
+type RequestRecoveryRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code ReturnCode    `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg  string        `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Case *RecoveryCase `protobuf:"bytes,3,opt,name=case,proto3" json:"case,omitempty"`
+}
+
+func (x *RequestRecoveryRep) Reset() {
+	*x = RequestRecoveryRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestRecoveryRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRecoveryRep) ProtoMessage() {}
+
+func (x *RequestRecoveryRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRecoveryRep.ProtoReflect.Descriptor instead.
+func (*RequestRecoveryRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RequestRecoveryRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *RequestRecoveryRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RequestRecoveryRep) GetCase() *RecoveryCase {
+	if x != nil {
+		return x.Case
+	}
+	return nil
+}
+
+// the guardian is recovered from the signature
+type ApproveRecoveryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	CaseId        string `protobuf:"bytes,2,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	Signature     string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ApproveRecoveryReq) Reset() {
+	*x = ApproveRecoveryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveRecoveryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRecoveryReq) ProtoMessage() {}
+
+func (x *ApproveRecoveryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRecoveryReq.ProtoReflect.Descriptor instead.
+func (*ApproveRecoveryReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ApproveRecoveryReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *ApproveRecoveryReq) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+func (x *ApproveRecoveryReq) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type ApproveRecoveryRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code ReturnCode    `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg  string        `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Case *RecoveryCase `protobuf:"bytes,3,opt,name=case,proto3" json:"case,omitempty"`
+}
+
+func (x *ApproveRecoveryRep) Reset() {
+	*x = ApproveRecoveryRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveRecoveryRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRecoveryRep) ProtoMessage() {}
+
+func (x *ApproveRecoveryRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRecoveryRep.ProtoReflect.Descriptor instead.
+func (*ApproveRecoveryRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ApproveRecoveryRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *ApproveRecoveryRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ApproveRecoveryRep) GetCase() *RecoveryCase {
+	if x != nil {
+		return x.Case
+	}
+	return nil
+}
+
+type GetRecoveryCaseReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	CaseId        string `protobuf:"bytes,2,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+}
+
+func (x *GetRecoveryCaseReq) Reset() {
+	*x = GetRecoveryCaseReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecoveryCaseReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecoveryCaseReq) ProtoMessage() {}
+
+func (x *GetRecoveryCaseReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecoveryCaseReq.ProtoReflect.Descriptor instead.
+func (*GetRecoveryCaseReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetRecoveryCaseReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *GetRecoveryCaseReq) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+type GetRecoveryCaseRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code ReturnCode    `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg  string        `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Case *RecoveryCase `protobuf:"bytes,3,opt,name=case,proto3" json:"case,omitempty"`
+}
+
+func (x *GetRecoveryCaseRep) Reset() {
+	*x = GetRecoveryCaseRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecoveryCaseRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecoveryCaseRep) ProtoMessage() {}
+
+func (x *GetRecoveryCaseRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecoveryCaseRep.ProtoReflect.Descriptor instead.
+func (*GetRecoveryCaseRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetRecoveryCaseRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *GetRecoveryCaseRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetRecoveryCaseRep) GetCase() *RecoveryCase {
+	if x != nil {
+		return x.Case
+	}
+	return nil
+}
+
+// a longer delay applies at once, a shorter one once the current delay has passed
+type SetRecoveryDelayReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	Delay         uint64 `protobuf:"varint,5,opt,name=delay,proto3" json:"delay,omitempty"`
+}
+
+func (x *SetRecoveryDelayReq) Reset() {
+	*x = SetRecoveryDelayReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRecoveryDelayReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRecoveryDelayReq) ProtoMessage() {}
+
+func (x *SetRecoveryDelayReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRecoveryDelayReq.ProtoReflect.Descriptor instead.
+func (*SetRecoveryDelayReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetRecoveryDelayReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *SetRecoveryDelayReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *SetRecoveryDelayReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SetRecoveryDelayReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *SetRecoveryDelayReq) GetDelay() uint64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+type SetRecoveryDelayRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code         ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg          string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Delay        uint64     `protobuf:"varint,3,opt,name=delay,proto3" json:"delay,omitempty"`
+	PendingDelay uint64     `protobuf:"varint,4,opt,name=pending_delay,json=pendingDelay,proto3" json:"pending_delay,omitempty"`
+	PendingAt    int64      `protobuf:"varint,5,opt,name=pending_at,json=pendingAt,proto3" json:"pending_at,omitempty"`
+}
+
+func (x *SetRecoveryDelayRep) Reset() {
+	*x = SetRecoveryDelayRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRecoveryDelayRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRecoveryDelayRep) ProtoMessage() {}
+
+func (x *SetRecoveryDelayRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRecoveryDelayRep.ProtoReflect.Descriptor instead.
+func (*SetRecoveryDelayRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetRecoveryDelayRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *SetRecoveryDelayRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SetRecoveryDelayRep) GetDelay() uint64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *SetRecoveryDelayRep) GetPendingDelay() uint64 {
+	if x != nil {
+		return x.PendingDelay
+	}
+	return 0
+}
+
+func (x *SetRecoveryDelayRep) GetPendingAt() int64 {
+	if x != nil {
+		return x.PendingAt
+	}
+	return 0
+}
+
+// the owner cancels the case with the wallet credentials
+type CancelRecoveryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	CaseId        string `protobuf:"bytes,2,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+}
+
+func (x *CancelRecoveryReq) Reset() {
+	*x = CancelRecoveryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelRecoveryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRecoveryReq) ProtoMessage() {}
+
+func (x *CancelRecoveryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRecoveryReq.ProtoReflect.Descriptor instead.
+func (*CancelRecoveryReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CancelRecoveryReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *CancelRecoveryReq) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+func (x *CancelRecoveryReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CancelRecoveryReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+type CancelRecoveryRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code ReturnCode    `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg  string        `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Case *RecoveryCase `protobuf:"bytes,3,opt,name=case,proto3" json:"case,omitempty"`
+}
+
+func (x *CancelRecoveryRep) Reset() {
+	*x = CancelRecoveryRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelRecoveryRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRecoveryRep) ProtoMessage() {}
+
+func (x *CancelRecoveryRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRecoveryRep.ProtoReflect.Descriptor instead.
+func (*CancelRecoveryRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CancelRecoveryRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *CancelRecoveryRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CancelRecoveryRep) GetCase() *RecoveryCase {
+	if x != nil {
+		return x.Case
+	}
+	return nil
+}
+
+// the keys of the wallet are re-encrypted to beneficiary_pub, a pem encoded rsa public key, once the owner
+// sent no heartbeat for period seconds. The owner is warned warn_before seconds ahead.
+// An empty beneficiary_pub removes the policy.
+type SetInheritanceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken  string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid     string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password       string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode     string `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	BeneficiaryPub string `protobuf:"bytes,5,opt,name=beneficiary_pub,json=beneficiaryPub,proto3" json:"beneficiary_pub,omitempty"`
+	Period         uint64 `protobuf:"varint,6,opt,name=period,proto3" json:"period,omitempty"`
+	WarnBefore     uint64 `protobuf:"varint,7,opt,name=warn_before,json=warnBefore,proto3" json:"warn_before,omitempty"`
+}
+
+func (x *SetInheritanceReq) Reset() {
+	*x = SetInheritanceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetInheritanceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInheritanceReq) ProtoMessage() {}
+
+func (x *SetInheritanceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInheritanceReq.ProtoReflect.Descriptor instead.
+func (*SetInheritanceReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetInheritanceReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *SetInheritanceReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *SetInheritanceReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SetInheritanceReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *SetInheritanceReq) GetBeneficiaryPub() string {
+	if x != nil {
+		return x.BeneficiaryPub
+	}
+	return ""
+}
+
+func (x *SetInheritanceReq) GetPeriod() uint64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *SetInheritanceReq) GetWarnBefore() uint64 {
+	if x != nil {
+		return x.WarnBefore
+	}
+	return 0
+}
+
+// status is one of active, warned or released
+type Inheritance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletUuid     string `protobuf:"bytes,1,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	BeneficiaryPub string `protobuf:"bytes,2,opt,name=beneficiary_pub,json=beneficiaryPub,proto3" json:"beneficiary_pub,omitempty"`
+	Period         uint64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	WarnBefore     uint64 `protobuf:"varint,4,opt,name=warn_before,json=warnBefore,proto3" json:"warn_before,omitempty"`
+	Status         string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	LastHeartbeat  int64  `protobuf:"varint,6,opt,name=last_heartbeat,json=lastHeartbeat,proto3" json:"last_heartbeat,omitempty"`
+	Deadline       int64  `protobuf:"varint,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (x *Inheritance) Reset() {
+	*x = Inheritance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Inheritance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Inheritance) ProtoMessage() {}
+
+func (x *Inheritance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Inheritance.ProtoReflect.Descriptor instead.
+func (*Inheritance) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Inheritance) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *Inheritance) GetBeneficiaryPub() string {
+	if x != nil {
+		return x.BeneficiaryPub
+	}
+	return ""
+}
+
+func (x *Inheritance) GetPeriod() uint64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *Inheritance) GetWarnBefore() uint64 {
+	if x != nil {
+		return x.WarnBefore
+	}
+	return 0
+}
+
+func (x *Inheritance) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Inheritance) GetLastHeartbeat() int64 {
+	if x != nil {
+		return x.LastHeartbeat
+	}
+	return 0
+}
+
+func (x *Inheritance) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+type SetInheritanceRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code        ReturnCode   `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg         string       `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Inheritance *Inheritance `protobuf:"bytes,3,opt,name=inheritance,proto3" json:"inheritance,omitempty"`
+}
+
+func (x *SetInheritanceRep) Reset() {
+	*x = SetInheritanceRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetInheritanceRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInheritanceRep) ProtoMessage() {}
+
+func (x *SetInheritanceRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInheritanceRep.ProtoReflect.Descriptor instead.
+func (*SetInheritanceRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetInheritanceRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *SetInheritanceRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SetInheritanceRep) GetInheritance() *Inheritance {
+	if x != nil {
+		return x.Inheritance
+	}
+	return nil
+}
+
+type HeartbeatReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+}
+
+func (x *HeartbeatReq) Reset() {
+	*x = HeartbeatReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatReq) ProtoMessage() {}
+
+func (x *HeartbeatReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatReq.ProtoReflect.Descriptor instead.
+func (*HeartbeatReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *HeartbeatReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *HeartbeatReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *HeartbeatReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *HeartbeatReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+type HeartbeatRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code        ReturnCode   `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg         string       `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Inheritance *Inheritance `protobuf:"bytes,3,opt,name=inheritance,proto3" json:"inheritance,omitempty"`
+}
+
+func (x *HeartbeatRep) Reset() {
+	*x = HeartbeatRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatRep) ProtoMessage() {}
+
+func (x *HeartbeatRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatRep.ProtoReflect.Descriptor instead.
+func (*HeartbeatRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *HeartbeatRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *HeartbeatRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *HeartbeatRep) GetInheritance() *Inheritance {
+	if x != nil {
+		return x.Inheritance
+	}
+	return nil
+}
+
+type GetInheritanceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+}
+
+func (x *GetInheritanceReq) Reset() {
+	*x = GetInheritanceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInheritanceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInheritanceReq) ProtoMessage() {}
+
+func (x *GetInheritanceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInheritanceReq.ProtoReflect.Descriptor instead.
+func (*GetInheritanceReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetInheritanceReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *GetInheritanceReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+type GetInheritanceRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code        ReturnCode   `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg         string       `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Inheritance *Inheritance `protobuf:"bytes,3,opt,name=inheritance,proto3" json:"inheritance,omitempty"`
+}
+
+func (x *GetInheritanceRep) Reset() {
+	*x = GetInheritanceRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInheritanceRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInheritanceRep) ProtoMessage() {}
+
+func (x *GetInheritanceRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInheritanceRep.ProtoReflect.Descriptor instead.
+func (*GetInheritanceRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetInheritanceRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *GetInheritanceRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetInheritanceRep) GetInheritance() *Inheritance {
+	if x != nil {
+		return x.Inheritance
+	}
+	return nil
+}
+
+// key is the base64 of the key encrypted with the public key of the beneficiary
+type InheritedKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain   string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Label   string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Version uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Key     string `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *InheritedKey) Reset() {
+	*x = InheritedKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InheritedKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InheritedKey) ProtoMessage() {}
+
+func (x *InheritedKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InheritedKey.ProtoReflect.Descriptor instead.
+func (*InheritedKey) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *InheritedKey) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *InheritedKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InheritedKey) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *InheritedKey) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *InheritedKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetInheritedKeysReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+}
+
+func (x *GetInheritedKeysReq) Reset() {
+	*x = GetInheritedKeysReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInheritedKeysReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInheritedKeysReq) ProtoMessage() {}
+
+func (x *GetInheritedKeysReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInheritedKeysReq.ProtoReflect.Descriptor instead.
+func (*GetInheritedKeysReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetInheritedKeysReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *GetInheritedKeysReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+type GetInheritedKeysRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    ReturnCode      `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg     string          `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	KeyList []*InheritedKey `protobuf:"bytes,3,rep,name=key_list,json=keyList,proto3" json:"key_list,omitempty"`
+}
+
+func (x *GetInheritedKeysRep) Reset() {
+	*x = GetInheritedKeysRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInheritedKeysRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInheritedKeysRep) ProtoMessage() {}
+
+func (x *GetInheritedKeysRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInheritedKeysRep.ProtoReflect.Descriptor instead.
+func (*GetInheritedKeysRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetInheritedKeysRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *GetInheritedKeysRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetInheritedKeysRep) GetKeyList() []*InheritedKey {
+	if x != nil {
+		return x.KeyList
+	}
+	return nil
+}
+
+// policy is credentials, the default, or guardians to also require an approved recovery case
+type ShareInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index  uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Chain  string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Share  []byte `protobuf:"bytes,3,opt,name=share,proto3" json:"share,omitempty"`
+	Policy string `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *ShareInput) Reset() {
+	*x = ShareInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareInput) ProtoMessage() {}
+
+func (x *ShareInput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareInput.ProtoReflect.Descriptor instead.
+func (*ShareInput) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ShareInput) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ShareInput) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ShareInput) GetShare() []byte {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+func (x *ShareInput) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+type ShareMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index   uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Chain   string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Policy  string `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	KeyId   string `protobuf:"bytes,4,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Version uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *ShareMeta) Reset() {
+	*x = ShareMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareMeta) ProtoMessage() {}
+
+func (x *ShareMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareMeta.ProtoReflect.Descriptor instead.
+func (*ShareMeta) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ShareMeta) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ShareMeta) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ShareMeta) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *ShareMeta) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *ShareMeta) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// each share is stored as a key of the wallet on its chain, encrypted like in setSocialKey
+type StoreSharesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string        `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string        `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string        `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string        `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	Shares        []*ShareInput `protobuf:"bytes,5,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *StoreSharesReq) Reset() {
+	*x = StoreSharesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreSharesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreSharesReq) ProtoMessage() {}
+
+func (x *StoreSharesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreSharesReq.ProtoReflect.Descriptor instead.
+func (*StoreSharesReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *StoreSharesReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *StoreSharesReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *StoreSharesReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *StoreSharesReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *StoreSharesReq) GetShares() []*ShareInput {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type StoreSharesRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       ReturnCode         `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg        string             `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	SetId      string             `protobuf:"bytes,3,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+	Epoch      uint64             `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Shares     []*ShareMeta       `protobuf:"bytes,5,rep,name=shares,proto3" json:"shares,omitempty"`
+	Violations []*PolicyViolation `protobuf:"bytes,6,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (x *StoreSharesRep) Reset() {
+	*x = StoreSharesRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreSharesRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreSharesRep) ProtoMessage() {}
+
+func (x *StoreSharesRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreSharesRep.ProtoReflect.Descriptor instead.
+func (*StoreSharesRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *StoreSharesRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *StoreSharesRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *StoreSharesRep) GetSetId() string {
+	if x != nil {
+		return x.SetId
+	}
+	return ""
+}
+
+func (x *StoreSharesRep) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *StoreSharesRep) GetShares() []*ShareMeta {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *StoreSharesRep) GetViolations() []*PolicyViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+// the new set replaces set_id, the active set, once all of its shares are stored
+type RefreshSharesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string        `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string        `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string        `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string        `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	Shares        []*ShareInput `protobuf:"bytes,5,rep,name=shares,proto3" json:"shares,omitempty"`
+	SetId         string        `protobuf:"bytes,6,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+}
+
+func (x *RefreshSharesReq) Reset() {
+	*x = RefreshSharesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshSharesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshSharesReq) ProtoMessage() {}
+
+func (x *RefreshSharesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshSharesReq.ProtoReflect.Descriptor instead.
+func (*RefreshSharesReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RefreshSharesReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *RefreshSharesReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *RefreshSharesReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RefreshSharesReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *RefreshSharesReq) GetShares() []*ShareInput {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *RefreshSharesReq) GetSetId() string {
+	if x != nil {
+		return x.SetId
+	}
+	return ""
+}
+
+type RefreshSharesRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       ReturnCode         `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg        string             `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	SetId      string             `protobuf:"bytes,3,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+	Epoch      uint64             `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Shares     []*ShareMeta       `protobuf:"bytes,5,rep,name=shares,proto3" json:"shares,omitempty"`
+	Violations []*PolicyViolation `protobuf:"bytes,6,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (x *RefreshSharesRep) Reset() {
+	*x = RefreshSharesRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshSharesRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshSharesRep) ProtoMessage() {}
+
+func (x *RefreshSharesRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshSharesRep.ProtoReflect.Descriptor instead.
+func (*RefreshSharesRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RefreshSharesRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *RefreshSharesRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RefreshSharesRep) GetSetId() string {
+	if x != nil {
+		return x.SetId
+	}
+	return ""
+}
+
+func (x *RefreshSharesRep) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *RefreshSharesRep) GetShares() []*ShareMeta {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *RefreshSharesRep) GetViolations() []*PolicyViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type GetShareReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	Index         uint32 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	// required by the shares with the guardians policy
+	RecoveryCaseId string `protobuf:"bytes,6,opt,name=recovery_case_id,json=recoveryCaseId,proto3" json:"recovery_case_id,omitempty"`
+	// issued by login, required when the wallet has an owner address
+	SessionToken string `protobuf:"bytes,7,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	// the current code of the authenticator app or a backup code, required once totp is enabled
+	TotpCode string `protobuf:"bytes,8,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	// a recovery code, replaces the totp_code and is burnt, the session_token is still required
+	RecoveryCode string `protobuf:"bytes,9,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+}
+
+func (x *GetShareReq) Reset() {
+	*x = GetShareReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetShareReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareReq) ProtoMessage() {}
+
+func (x *GetShareReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareReq.ProtoReflect.Descriptor instead.
+func (*GetShareReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetShareReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *GetShareReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *GetShareReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GetShareReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *GetShareReq) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GetShareReq) GetRecoveryCaseId() string {
+	if x != nil {
+		return x.RecoveryCaseId
+	}
+	return ""
+}
+
+func (x *GetShareReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *GetShareReq) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+func (x *GetShareReq) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+type GetShareRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg   string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	SetId string     `protobuf:"bytes,3,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+	Epoch uint64     `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Index uint32     `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	Share []byte     `protobuf:"bytes,6,opt,name=share,proto3" json:"share,omitempty"`
+}
+
+func (x *GetShareRep) Reset() {
+	*x = GetShareRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetShareRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareRep) ProtoMessage() {}
+
+func (x *GetShareRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareRep.ProtoReflect.Descriptor instead.
+func (*GetShareRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetShareRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *GetShareRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetShareRep) GetSetId() string {
+	if x != nil {
+		return x.SetId
+	}
+	return ""
+}
+
+func (x *GetShareRep) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *GetShareRep) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GetShareRep) GetShare() []byte {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+type ListSharesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+}
+
+func (x *ListSharesReq) Reset() {
+	*x = ListSharesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSharesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharesReq) ProtoMessage() {}
+
+func (x *ListSharesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharesReq.ProtoReflect.Descriptor instead.
+func (*ListSharesReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListSharesReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *ListSharesReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+type ListSharesRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code   ReturnCode   `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg    string       `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	SetId  string       `protobuf:"bytes,3,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+	Epoch  uint64       `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Shares []*ShareMeta `protobuf:"bytes,5,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *ListSharesRep) Reset() {
+	*x = ListSharesRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSharesRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharesRep) ProtoMessage() {}
+
+func (x *ListSharesRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharesRep.ProtoReflect.Descriptor instead.
+func (*ListSharesRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListSharesRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *ListSharesRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListSharesRep) GetSetId() string {
+	if x != nil {
+		return x.SetId
+	}
+	return ""
+}
+
+func (x *ListSharesRep) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ListSharesRep) GetShares() []*ShareMeta {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+// the stored key is a hex encoded secp256k1 private key, it is only decrypted in memory to sign
+type SignWithSocialKeyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	WalletUuid    string `protobuf:"bytes,3,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,5,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	// required when the wallet has more than one key
+	KeyId          string `protobuf:"bytes,6,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Version        uint64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	RecoveryCaseId string `protobuf:"bytes,8,opt,name=recovery_case_id,json=recoveryCaseId,proto3" json:"recovery_case_id,omitempty"`
+	// transaction, message or typed_data
+	Kind string `protobuf:"bytes,9,opt,name=kind,proto3" json:"kind,omitempty"`
+	// the unsigned transaction in its binary encoding, the raw EIP-191 message or the EIP-712 json
+	Payload []byte `protobuf:"bytes,10,opt,name=payload,proto3" json:"payload,omitempty"`
+	// the EIP-155 chain id of a transaction
+	ChainId uint64 `protobuf:"varint,11,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// issued by login, required when the wallet has an owner address
+	SessionToken string `protobuf:"bytes,12,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	// the current code of the authenticator app or a backup code, required once totp is enabled
+	TotpCode string `protobuf:"bytes,13,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	// a recovery code, replaces the totp_code and is burnt, the session_token is still required
+	RecoveryCode string `protobuf:"bytes,14,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+}
+
+func (x *SignWithSocialKeyReq) Reset() {
+	*x = SignWithSocialKeyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignWithSocialKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignWithSocialKeyReq) ProtoMessage() {}
+
+func (x *SignWithSocialKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignWithSocialKeyReq.ProtoReflect.Descriptor instead.
+func (*SignWithSocialKeyReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SignWithSocialKeyReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *SignWithSocialKeyReq) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *SignWithSocialKeyReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *SignWithSocialKeyReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SignWithSocialKeyReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *SignWithSocialKeyReq) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *SignWithSocialKeyReq) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SignWithSocialKeyReq) GetRecoveryCaseId() string {
+	if x != nil {
+		return x.RecoveryCaseId
+	}
+	return ""
+}
+
+func (x *SignWithSocialKeyReq) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SignWithSocialKeyReq) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *SignWithSocialKeyReq) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *SignWithSocialKeyReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SignWithSocialKeyReq) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+func (x *SignWithSocialKeyReq) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+// signature is r, s and v, v is 27/28 for messages and typed data. signed_tx is the signed transaction
+// in its binary encoding
+type SignWithSocialKeyRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg       string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Signature []byte     `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	SignedTx  []byte     `protobuf:"bytes,4,opt,name=signed_tx,json=signedTx,proto3" json:"signed_tx,omitempty"`
+	Address   string     `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Hash      string     `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *SignWithSocialKeyRep) Reset() {
+	*x = SignWithSocialKeyRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignWithSocialKeyRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignWithSocialKeyRep) ProtoMessage() {}
+
+func (x *SignWithSocialKeyRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignWithSocialKeyRep.ProtoReflect.Descriptor instead.
+func (*SignWithSocialKeyRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SignWithSocialKeyRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *SignWithSocialKeyRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SignWithSocialKeyRep) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *SignWithSocialKeyRep) GetSignedTx() []byte {
+	if x != nil {
+		return x.SignedTx
+	}
+	return nil
+}
+
+func (x *SignWithSocialKeyRep) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SignWithSocialKeyRep) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+// DeriveAccountsReq derives public keys from the BIP39 mnemonic stored as the key, the mnemonic
+// never leaves the locker. Every account is derived at m/44'/coin_type'/account', paths adds
+// single BIP32 paths like m/84'/0'/0'/0/1
+type DeriveAccountsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	WalletUuid    string `protobuf:"bytes,3,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,5,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	// required when the wallet has more than one key
+	KeyId          string `protobuf:"bytes,6,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Version        uint64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	RecoveryCaseId string `protobuf:"bytes,8,opt,name=recovery_case_id,json=recoveryCaseId,proto3" json:"recovery_case_id,omitempty"`
+	// the BIP39 passphrase, empty for none
+	Passphrase string          `protobuf:"bytes,9,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Accounts   []*AccountQuery `protobuf:"bytes,10,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Paths      []string        `protobuf:"bytes,11,rep,name=paths,proto3" json:"paths,omitempty"`
+	// issued by login, required when the wallet has an owner address
+	SessionToken string `protobuf:"bytes,12,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	// the current code of the authenticator app or a backup code, required once totp is enabled
+	TotpCode string `protobuf:"bytes,13,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	// a recovery code, replaces the totp_code and is burnt, the session_token is still required
+	RecoveryCode string `protobuf:"bytes,14,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+}
+
+func (x *DeriveAccountsReq) Reset() {
+	*x = DeriveAccountsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeriveAccountsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeriveAccountsReq) ProtoMessage() {}
+
+func (x *DeriveAccountsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeriveAccountsReq.ProtoReflect.Descriptor instead.
+func (*DeriveAccountsReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeriveAccountsReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *DeriveAccountsReq) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *DeriveAccountsReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *DeriveAccountsReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DeriveAccountsReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *DeriveAccountsReq) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *DeriveAccountsReq) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DeriveAccountsReq) GetRecoveryCaseId() string {
+	if x != nil {
+		return x.RecoveryCaseId
+	}
+	return ""
+}
+
+func (x *DeriveAccountsReq) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *DeriveAccountsReq) GetAccounts() []*AccountQuery {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *DeriveAccountsReq) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *DeriveAccountsReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *DeriveAccountsReq) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+func (x *DeriveAccountsReq) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+// AccountQuery selects the addresses m/44'/coin_type'/account'/change/index, index from start to start+count
+type AccountQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the SLIP-44 coin type, 0 for bitcoin, 1 for bitcoin testnet and 60 for ethereum
+	CoinType uint32 `protobuf:"varint,1,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Account  uint32 `protobuf:"varint,2,opt,name=account,proto3" json:"account,omitempty"`
+	Change   uint32 `protobuf:"varint,3,opt,name=change,proto3" json:"change,omitempty"`
+	Start    uint32 `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	// at most 100, no address is derived when 0
+	Count uint32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AccountQuery) Reset() {
+	*x = AccountQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountQuery) ProtoMessage() {}
+
+func (x *AccountQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountQuery.ProtoReflect.Descriptor instead.
+func (*AccountQuery) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *AccountQuery) GetCoinType() uint32 {
+	if x != nil {
+		return x.CoinType
+	}
+	return 0
+}
+
+func (x *AccountQuery) GetAccount() uint32 {
+	if x != nil {
+		return x.Account
+	}
+	return 0
+}
+
+func (x *AccountQuery) GetChange() uint32 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *AccountQuery) GetStart() uint32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *AccountQuery) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// address is empty for the coin types and purposes without a known address format
+type DerivedAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// the compressed public key in hex
+	PublicKey string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *DerivedAddress) Reset() {
+	*x = DerivedAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DerivedAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DerivedAddress) ProtoMessage() {}
+
+func (x *DerivedAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DerivedAddress.ProtoReflect.Descriptor instead.
+func (*DerivedAddress) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DerivedAddress) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DerivedAddress) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *DerivedAddress) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// xpub is the extended public key of the account, tpub for the bitcoin testnet
+type DerivedAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path      string            `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	CoinType  uint32            `protobuf:"varint,2,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Account   uint32            `protobuf:"varint,3,opt,name=account,proto3" json:"account,omitempty"`
+	Xpub      string            `protobuf:"bytes,4,opt,name=xpub,proto3" json:"xpub,omitempty"`
+	Addresses []*DerivedAddress `protobuf:"bytes,5,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *DerivedAccount) Reset() {
+	*x = DerivedAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DerivedAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DerivedAccount) ProtoMessage() {}
+
+func (x *DerivedAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DerivedAccount.ProtoReflect.Descriptor instead.
+func (*DerivedAccount) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DerivedAccount) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DerivedAccount) GetCoinType() uint32 {
+	if x != nil {
+		return x.CoinType
+	}
+	return 0
+}
+
+func (x *DerivedAccount) GetAccount() uint32 {
+	if x != nil {
+		return x.Account
+	}
+	return 0
+}
+
+func (x *DerivedAccount) GetXpub() string {
+	if x != nil {
+		return x.Xpub
+	}
+	return ""
+}
+
+func (x *DerivedAccount) GetAddresses() []*DerivedAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type DeriveAccountsRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      ReturnCode        `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg       string            `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Accounts  []*DerivedAccount `protobuf:"bytes,3,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Addresses []*DerivedAddress `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *DeriveAccountsRep) Reset() {
+	*x = DeriveAccountsRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeriveAccountsRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeriveAccountsRep) ProtoMessage() {}
+
+func (x *DeriveAccountsRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeriveAccountsRep.ProtoReflect.Descriptor instead.
+func (*DeriveAccountsRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DeriveAccountsRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *DeriveAccountsRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *DeriveAccountsRep) GetAccounts() []*DerivedAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *DeriveAccountsRep) GetAddresses() []*DerivedAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+// TransferSocialKeyReq moves the latest version of the keys of the wallet on the chain to new_wallet_uuid,
+// authenticated with the credentials of the source. The keys are encrypted with the rsa pair of the target,
+// which is unlocked with new_password and new_social_code and created when it does not exist, or with
+// public_key, whose owner keeps the private key and decrypts the keys returned by getSocialKey
+type TransferSocialKeyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	WalletUuid    string `protobuf:"bytes,3,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,5,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	// only this key, all the keys of the wallet on the chain when empty
+	KeyId          string `protobuf:"bytes,6,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	RecoveryCaseId string `protobuf:"bytes,7,opt,name=recovery_case_id,json=recoveryCaseId,proto3" json:"recovery_case_id,omitempty"`
+	NewWalletUuid  string `protobuf:"bytes,8,opt,name=new_wallet_uuid,json=newWalletUuid,proto3" json:"new_wallet_uuid,omitempty"`
+	// encrypted with the aes_key like password
+	NewPassword   string `protobuf:"bytes,9,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	NewSocialCode string `protobuf:"bytes,10,opt,name=new_social_code,json=newSocialCode,proto3" json:"new_social_code,omitempty"`
+	// a pem encoded rsa public key, instead of new_password and new_social_code
+	PublicKey string `protobuf:"bytes,11,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// issued by login, required when the wallet has an owner address
+	SessionToken string `protobuf:"bytes,12,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	// the current code of the authenticator app or a backup code, required once totp is enabled
+	TotpCode string `protobuf:"bytes,13,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	// a recovery code, replaces the totp_code and is burnt, the session_token is still required
+	RecoveryCode string `protobuf:"bytes,14,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+}
+
+func (x *TransferSocialKeyReq) Reset() {
+	*x = TransferSocialKeyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferSocialKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferSocialKeyReq) ProtoMessage() {}
+
+func (x *TransferSocialKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferSocialKeyReq.ProtoReflect.Descriptor instead.
+func (*TransferSocialKeyReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *TransferSocialKeyReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *TransferSocialKeyReq) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *TransferSocialKeyReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *TransferSocialKeyReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *TransferSocialKeyReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *TransferSocialKeyReq) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *TransferSocialKeyReq) GetRecoveryCaseId() string {
+	if x != nil {
+		return x.RecoveryCaseId
+	}
+	return ""
+}
+
+func (x *TransferSocialKeyReq) GetNewWalletUuid() string {
+	if x != nil {
+		return x.NewWalletUuid
+	}
+	return ""
+}
+
+func (x *TransferSocialKeyReq) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *TransferSocialKeyReq) GetNewSocialCode() string {
+	if x != nil {
+		return x.NewSocialCode
+	}
+	return ""
+}
+
+func (x *TransferSocialKeyReq) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *TransferSocialKeyReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *TransferSocialKeyReq) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+func (x *TransferSocialKeyReq) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+type TransferredKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyId      string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Version    uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	NewKeyId   string `protobuf:"bytes,3,opt,name=new_key_id,json=newKeyId,proto3" json:"new_key_id,omitempty"`
+	NewVersion uint64 `protobuf:"varint,4,opt,name=new_version,json=newVersion,proto3" json:"new_version,omitempty"`
+	JobId      string `protobuf:"bytes,5,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	TxHash     string `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	FileCid    string `protobuf:"bytes,7,opt,name=file_cid,json=fileCid,proto3" json:"file_cid,omitempty"`
+}
+
+func (x *TransferredKey) Reset() {
+	*x = TransferredKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferredKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferredKey) ProtoMessage() {}
+
+func (x *TransferredKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferredKey.ProtoReflect.Descriptor instead.
+func (*TransferredKey) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *TransferredKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *TransferredKey) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TransferredKey) GetNewKeyId() string {
+	if x != nil {
+		return x.NewKeyId
+	}
+	return ""
+}
+
+func (x *TransferredKey) GetNewVersion() uint64 {
+	if x != nil {
+		return x.NewVersion
+	}
+	return 0
+}
+
+func (x *TransferredKey) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *TransferredKey) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *TransferredKey) GetFileCid() string {
+	if x != nil {
+		return x.FileCid
+	}
+	return ""
+}
+
+// keys are the keys transferred, also when a later key failed
+type TransferSocialKeyRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       ReturnCode         `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg        string             `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Keys       []*TransferredKey  `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	Violations []*PolicyViolation `protobuf:"bytes,4,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (x *TransferSocialKeyRep) Reset() {
+	*x = TransferSocialKeyRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferSocialKeyRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferSocialKeyRep) ProtoMessage() {}
+
+func (x *TransferSocialKeyRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferSocialKeyRep.ProtoReflect.Descriptor instead.
+func (*TransferSocialKeyRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *TransferSocialKeyRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *TransferSocialKeyRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *TransferSocialKeyRep) GetKeys() []*TransferredKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *TransferSocialKeyRep) GetViolations() []*PolicyViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+// ChangeCredentialsReq re-encrypts the rsa private key of the wallet with new_password and new_social_code,
+// encrypted with the aes_key like password. The stored keys are left untouched.
+type ChangeCredentialsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	NewPassword   string `protobuf:"bytes,5,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	NewSocialCode string `protobuf:"bytes,6,opt,name=new_social_code,json=newSocialCode,proto3" json:"new_social_code,omitempty"`
+}
+
+func (x *ChangeCredentialsReq) Reset() {
+	*x = ChangeCredentialsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeCredentialsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeCredentialsReq) ProtoMessage() {}
+
+func (x *ChangeCredentialsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeCredentialsReq.ProtoReflect.Descriptor instead.
+func (*ChangeCredentialsReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ChangeCredentialsReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *ChangeCredentialsReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *ChangeCredentialsReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ChangeCredentialsReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *ChangeCredentialsReq) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *ChangeCredentialsReq) GetNewSocialCode() string {
+	if x != nil {
+		return x.NewSocialCode
+	}
+	return ""
+}
+
+type ChangeCredentialsRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       ReturnCode         `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg        string             `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Violations []*PolicyViolation `protobuf:"bytes,3,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (x *ChangeCredentialsRep) Reset() {
+	*x = ChangeCredentialsRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeCredentialsRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeCredentialsRep) ProtoMessage() {}
+
+func (x *ChangeCredentialsRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeCredentialsRep.ProtoReflect.Descriptor instead.
+func (*ChangeCredentialsRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ChangeCredentialsRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *ChangeCredentialsRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ChangeCredentialsRep) GetViolations() []*PolicyViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+// PolicyViolation is a rule of the credential policy broken with CREDENTIAL_POLICY, rule is one of min_entropy,
+// banned_password, min_social_code_length and max_age. pattern is the weakest pattern of a password breaking
+// min_entropy, one of dictionary, repeat, sequence, keyboard and year, empty when it is only too short.
+type PolicyViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule    string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Pattern string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+}
+
+func (x *PolicyViolation) Reset() {
+	*x = PolicyViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyViolation) ProtoMessage() {}
+
+func (x *PolicyViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyViolation.ProtoReflect.Descriptor instead.
+func (*PolicyViolation) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PolicyViolation) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *PolicyViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PolicyViolation) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+// VerifyCredentialsReq checks the password and social code of the wallet by unlocking its rsa key pair and
+// decrypting the latest key stored on the chain, no key is returned
+type VerifyCredentialsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	WalletUuid    string `protobuf:"bytes,3,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,5,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+}
+
+func (x *VerifyCredentialsReq) Reset() {
+	*x = VerifyCredentialsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyCredentialsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCredentialsReq) ProtoMessage() {}
+
+func (x *VerifyCredentialsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCredentialsReq.ProtoReflect.Descriptor instead.
+func (*VerifyCredentialsReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *VerifyCredentialsReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *VerifyCredentialsReq) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *VerifyCredentialsReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *VerifyCredentialsReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *VerifyCredentialsReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+type VerifyCredentialsRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *VerifyCredentialsRep) Reset() {
+	*x = VerifyCredentialsRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyCredentialsRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCredentialsRep) ProtoMessage() {}
+
+func (x *VerifyCredentialsRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCredentialsRep.ProtoReflect.Descriptor instead.
+func (*VerifyCredentialsRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *VerifyCredentialsRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *VerifyCredentialsRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// SetOwnerAddressReq sets the ethereum account of the owner of the wallet, whose EIP-712 signatures
+// can authenticate the owner instead of the credentials. An empty address removes it.
+type SetOwnerAddressReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	Address       string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	// once the wallet has an owner address, replacing it also takes a session_token issued by login with the
+	// current owner, or the signature of the current owner over ChangeOwner(string walletUuid,uint256 deadline)
+	SessionToken string `protobuf:"bytes,6,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Deadline     int64  `protobuf:"varint,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Signature    string `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *SetOwnerAddressReq) Reset() {
+	*x = SetOwnerAddressReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOwnerAddressReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOwnerAddressReq) ProtoMessage() {}
+
+func (x *SetOwnerAddressReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOwnerAddressReq.ProtoReflect.Descriptor instead.
+func (*SetOwnerAddressReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SetOwnerAddressReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *SetOwnerAddressReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *SetOwnerAddressReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SetOwnerAddressReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *SetOwnerAddressReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SetOwnerAddressReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SetOwnerAddressReq) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *SetOwnerAddressReq) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type SetOwnerAddressRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *SetOwnerAddressRep) Reset() {
+	*x = SetOwnerAddressRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOwnerAddressRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOwnerAddressRep) ProtoMessage() {}
+
+func (x *SetOwnerAddressRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOwnerAddressRep.ProtoReflect.Descriptor instead.
+func (*SetOwnerAddressRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SetOwnerAddressRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *SetOwnerAddressRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// FreezeWalletReq and UnfreezeWalletReq are authenticated by the credentials or by the owner signing the
+// typed data Freeze(string walletUuid,uint256 deadline) or Unfreeze(string walletUuid,uint256 deadline),
+// deadline is a unix time at most an hour ahead
+type FreezeWalletReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	Deadline      int64  `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Signature     string `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	Reason        string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *FreezeWalletReq) Reset() {
+	*x = FreezeWalletReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreezeWalletReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeWalletReq) ProtoMessage() {}
+
+func (x *FreezeWalletReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeWalletReq.ProtoReflect.Descriptor instead.
+func (*FreezeWalletReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *FreezeWalletReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *FreezeWalletReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *FreezeWalletReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *FreezeWalletReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *FreezeWalletReq) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *FreezeWalletReq) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *FreezeWalletReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type FreezeWalletRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *FreezeWalletRep) Reset() {
+	*x = FreezeWalletRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreezeWalletRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeWalletRep) ProtoMessage() {}
+
+func (x *FreezeWalletRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeWalletRep.ProtoReflect.Descriptor instead.
+func (*FreezeWalletRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *FreezeWalletRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *FreezeWalletRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// an unfreeze proven by both the credentials and the owner signature is immediate,
+// otherwise the wallet stays frozen until unfreeze_at
+type UnfreezeWalletReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	Deadline      int64  `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Signature     string `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *UnfreezeWalletReq) Reset() {
+	*x = UnfreezeWalletReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfreezeWalletReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfreezeWalletReq) ProtoMessage() {}
+
+func (x *UnfreezeWalletReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfreezeWalletReq.ProtoReflect.Descriptor instead.
+func (*UnfreezeWalletReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UnfreezeWalletReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *UnfreezeWalletReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *UnfreezeWalletReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *UnfreezeWalletReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *UnfreezeWalletReq) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *UnfreezeWalletReq) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type UnfreezeWalletRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg        string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	UnfreezeAt int64      `protobuf:"varint,3,opt,name=unfreeze_at,json=unfreezeAt,proto3" json:"unfreeze_at,omitempty"`
+}
+
+func (x *UnfreezeWalletRep) Reset() {
+	*x = UnfreezeWalletRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfreezeWalletRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfreezeWalletRep) ProtoMessage() {}
+
+func (x *UnfreezeWalletRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfreezeWalletRep.ProtoReflect.Descriptor instead.
+func (*UnfreezeWalletRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UnfreezeWalletRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *UnfreezeWalletRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *UnfreezeWalletRep) GetUnfreezeAt() int64 {
+	if x != nil {
+		return x.UnfreezeAt
+	}
+	return 0
+}
+
+// GetChallengeReq returns the EIP-4361 message the owner address of the wallet signs to login
+type GetChallengeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+}
+
+func (x *GetChallengeReq) Reset() {
+	*x = GetChallengeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChallengeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeReq) ProtoMessage() {}
+
+func (x *GetChallengeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeReq.ProtoReflect.Descriptor instead.
+func (*GetChallengeReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetChallengeReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *GetChallengeReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+type GetChallengeRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg       string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Message   string     `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Nonce     string     `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ExpiresAt int64      `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *GetChallengeRep) Reset() {
+	*x = GetChallengeRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChallengeRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeRep) ProtoMessage() {}
+
+func (x *GetChallengeRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeRep.ProtoReflect.Descriptor instead.
+func (*GetChallengeRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetChallengeRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *GetChallengeRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetChallengeRep) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetChallengeRep) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *GetChallengeRep) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// LoginReq is the message of getChallenge with its EIP-191 personal_sign signature
+type LoginReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Signature     string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *LoginReq) Reset() {
+	*x = LoginReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginReq) ProtoMessage() {}
+
+func (x *LoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginReq.ProtoReflect.Descriptor instead.
+func (*LoginReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *LoginReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *LoginReq) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LoginReq) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+// session_token is given to the retrievals of the wallet until expires_at, or until its credentials
+// or owner address change
+type LoginRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code         ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg          string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	SessionToken string     `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	ExpiresAt    int64      `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *LoginRep) Reset() {
+	*x = LoginRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRep) ProtoMessage() {}
+
+func (x *LoginRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRep.ProtoReflect.Descriptor instead.
+func (*LoginRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *LoginRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *LoginRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *LoginRep) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *LoginRep) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// EnrollTotpReq starts the totp enrollment of the wallet, it is enforced once confirmed by confirmTotp
+type EnrollTotpReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+}
+
+func (x *EnrollTotpReq) Reset() {
+	*x = EnrollTotpReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollTotpReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTotpReq) ProtoMessage() {}
+
+func (x *EnrollTotpReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTotpReq.ProtoReflect.Descriptor instead.
+func (*EnrollTotpReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *EnrollTotpReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *EnrollTotpReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *EnrollTotpReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *EnrollTotpReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+// secret is base32 encoded, uri is the otpauth uri of the authenticator apps
+type EnrollTotpRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code   ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg    string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Secret string     `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Uri    string     `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *EnrollTotpRep) Reset() {
+	*x = EnrollTotpRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollTotpRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTotpRep) ProtoMessage() {}
+
+func (x *EnrollTotpRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTotpRep.ProtoReflect.Descriptor instead.
+func (*EnrollTotpRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *EnrollTotpRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *EnrollTotpRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *EnrollTotpRep) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTotpRep) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type ConfirmTotpReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	TotpCode      string `protobuf:"bytes,5,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+}
+
+func (x *ConfirmTotpReq) Reset() {
+	*x = ConfirmTotpReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmTotpReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTotpReq) ProtoMessage() {}
+
+func (x *ConfirmTotpReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTotpReq.ProtoReflect.Descriptor instead.
+func (*ConfirmTotpReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ConfirmTotpReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *ConfirmTotpReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *ConfirmTotpReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ConfirmTotpReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *ConfirmTotpReq) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+// backup_codes are returned once, each can replace a totp code once
+type ConfirmTotpRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code        ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg         string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	BackupCodes []string   `protobuf:"bytes,3,rep,name=backup_codes,json=backupCodes,proto3" json:"backup_codes,omitempty"`
+}
+
+func (x *ConfirmTotpRep) Reset() {
+	*x = ConfirmTotpRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmTotpRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTotpRep) ProtoMessage() {}
+
+func (x *ConfirmTotpRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTotpRep.ProtoReflect.Descriptor instead.
+func (*ConfirmTotpRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ConfirmTotpRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *ConfirmTotpRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ConfirmTotpRep) GetBackupCodes() []string {
+	if x != nil {
+		return x.BackupCodes
+	}
+	return nil
+}
+
+type DisableTotpReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	TotpCode      string `protobuf:"bytes,5,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+}
+
+func (x *DisableTotpReq) Reset() {
+	*x = DisableTotpReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableTotpReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTotpReq) ProtoMessage() {}
+
+func (x *DisableTotpReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTotpReq.ProtoReflect.Descriptor instead.
+func (*DisableTotpReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DisableTotpReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *DisableTotpReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *DisableTotpReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DisableTotpReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *DisableTotpReq) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+type DisableTotpRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *DisableTotpRep) Reset() {
+	*x = DisableTotpRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableTotpRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTotpRep) ProtoMessage() {}
+
+func (x *DisableTotpRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTotpRep.ProtoReflect.Descriptor instead.
+func (*DisableTotpRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DisableTotpRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *DisableTotpRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// GenerateRecoveryCodesReq replaces the recovery codes of the wallet, it needs the factors of a retrieval
+type GenerateRecoveryCodesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	SessionToken  string `protobuf:"bytes,5,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	TotpCode      string `protobuf:"bytes,6,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	RecoveryCode  string `protobuf:"bytes,7,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+}
+
+func (x *GenerateRecoveryCodesReq) Reset() {
+	*x = GenerateRecoveryCodesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateRecoveryCodesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRecoveryCodesReq) ProtoMessage() {}
+
+func (x *GenerateRecoveryCodesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRecoveryCodesReq.ProtoReflect.Descriptor instead.
+func (*GenerateRecoveryCodesReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GenerateRecoveryCodesReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *GenerateRecoveryCodesReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *GenerateRecoveryCodesReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GenerateRecoveryCodesReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *GenerateRecoveryCodesReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *GenerateRecoveryCodesReq) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+func (x *GenerateRecoveryCodesReq) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+// codes are returned once, only their hashes are stored
+type GenerateRecoveryCodesRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg   string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Codes []string   `protobuf:"bytes,3,rep,name=codes,proto3" json:"codes,omitempty"`
+}
+
+func (x *GenerateRecoveryCodesRep) Reset() {
+	*x = GenerateRecoveryCodesRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateRecoveryCodesRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRecoveryCodesRep) ProtoMessage() {}
+
+func (x *GenerateRecoveryCodesRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRecoveryCodesRep.ProtoReflect.Descriptor instead.
+func (*GenerateRecoveryCodesRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GenerateRecoveryCodesRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *GenerateRecoveryCodesRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GenerateRecoveryCodesRep) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+type CountRecoveryCodesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SocialCode    string `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+}
+
+func (x *CountRecoveryCodesReq) Reset() {
+	*x = CountRecoveryCodesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountRecoveryCodesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountRecoveryCodesReq) ProtoMessage() {}
+
+func (x *CountRecoveryCodesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountRecoveryCodesReq.ProtoReflect.Descriptor instead.
+func (*CountRecoveryCodesReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CountRecoveryCodesReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *CountRecoveryCodesReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *CountRecoveryCodesReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CountRecoveryCodesReq) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+type CountRecoveryCodesRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg       string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Remaining uint32     `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (x *CountRecoveryCodesRep) Reset() {
+	*x = CountRecoveryCodesRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountRecoveryCodesRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountRecoveryCodesRep) ProtoMessage() {}
+
+func (x *CountRecoveryCodesRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountRecoveryCodesRep.ProtoReflect.Descriptor instead.
+func (*CountRecoveryCodesRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *CountRecoveryCodesRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *CountRecoveryCodesRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CountRecoveryCodesRep) GetRemaining() uint32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+// IssueSocialCodeReq issues a social code for the wallet to be delivered through channel, like email or sms.
+// It is restricted to the admin token. A wallet with issued codes only stores keys with one of them.
+type IssueSocialCodeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	Channel       string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	// a reusable code can store keys until it expires, a single use code stores one key
+	Reusable bool `protobuf:"varint,4,opt,name=reusable,proto3" json:"reusable,omitempty"`
+	// seconds the code is valid, social_code.ttl of the config when 0
+	Ttl uint64 `protobuf:"varint,5,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *IssueSocialCodeReq) Reset() {
+	*x = IssueSocialCodeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueSocialCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueSocialCodeReq) ProtoMessage() {}
+
+func (x *IssueSocialCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueSocialCodeReq.ProtoReflect.Descriptor instead.
+func (*IssueSocialCodeReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *IssueSocialCodeReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *IssueSocialCodeReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *IssueSocialCodeReq) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *IssueSocialCodeReq) GetReusable() bool {
+	if x != nil {
+		return x.Reusable
+	}
+	return false
+}
+
+func (x *IssueSocialCodeReq) GetTtl() uint64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+// social_code is returned once, in clear, for the consumer to deliver it
+type IssueSocialCodeRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg        string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	CodeId     string     `protobuf:"bytes,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	SocialCode string     `protobuf:"bytes,4,opt,name=social_code,json=socialCode,proto3" json:"social_code,omitempty"`
+	ExpiresAt  int64      `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *IssueSocialCodeRep) Reset() {
+	*x = IssueSocialCodeRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueSocialCodeRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueSocialCodeRep) ProtoMessage() {}
+
+func (x *IssueSocialCodeRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueSocialCodeRep.ProtoReflect.Descriptor instead.
+func (*IssueSocialCodeRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *IssueSocialCodeRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *IssueSocialCodeRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *IssueSocialCodeRep) GetCodeId() string {
+	if x != nil {
+		return x.CodeId
+	}
+	return ""
+}
+
+func (x *IssueSocialCodeRep) GetSocialCode() string {
+	if x != nil {
+		return x.SocialCode
+	}
+	return ""
+}
+
+func (x *IssueSocialCodeRep) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// RevokeSocialCodeReq revokes the code_id of the wallet, every code of the wallet when empty.
+// It is restricted to the admin token.
+type RevokeSocialCodeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	WalletUuid    string `protobuf:"bytes,2,opt,name=wallet_uuid,json=walletUuid,proto3" json:"wallet_uuid,omitempty"`
+	CodeId        string `protobuf:"bytes,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+}
+
+func (x *RevokeSocialCodeReq) Reset() {
+	*x = RevokeSocialCodeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeSocialCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSocialCodeReq) ProtoMessage() {}
+
+func (x *RevokeSocialCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSocialCodeReq.ProtoReflect.Descriptor instead.
+func (*RevokeSocialCodeReq) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *RevokeSocialCodeReq) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *RevokeSocialCodeReq) GetWalletUuid() string {
+	if x != nil {
+		return x.WalletUuid
+	}
+	return ""
+}
+
+func (x *RevokeSocialCodeReq) GetCodeId() string {
+	if x != nil {
+		return x.CodeId
+	}
+	return ""
+}
+
+type RevokeSocialCodeRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=savourrpc.keylocker.ReturnCode" json:"code,omitempty"`
+	Msg     string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Revoked uint32     `protobuf:"varint,3,opt,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (x *RevokeSocialCodeRep) Reset() {
+	*x = RevokeSocialCodeRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_keylocker_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeSocialCodeRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSocialCodeRep) ProtoMessage() {}
+
+func (x *RevokeSocialCodeRep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_keylocker_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSocialCodeRep.ProtoReflect.Descriptor instead.
+func (*RevokeSocialCodeRep) Descriptor() ([]byte, []int) {
+	return file_proto_keylocker_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *RevokeSocialCodeRep) GetCode() ReturnCode {
+	if x != nil {
+		return x.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (x *RevokeSocialCodeRep) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RevokeSocialCodeRep) GetRevoked() uint32 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+var File_proto_keylocker_proto protoreflect.FileDescriptor
+
+var file_proto_keylocker_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x22, 0x5d, 0x0a, 0x09,
+	0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x68, 0x0a, 0x0f, 0x53,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0x72, 0x0a, 0x0f, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x94, 0x02, 0x0a, 0x0f, 0x53, 0x65,
+	0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64,
+	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x4b, 0x65, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x22, 0xfb, 0x02, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b,
+	0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x70,
+	0x75, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x75, 0x62, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x72, 0x69, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x72, 0x69,
+	0x76, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f, 0x77, 0x61, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x57, 0x61, 0x79,
+	0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xcc,
+	0x02, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6b,
+	0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10,
+	0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x93, 0x01,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x39, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x61, 0x76,
+	0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x8d, 0x03, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x53,
+	0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69,
+	0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x69,
+	0x64, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63,
+	0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x53,
+	0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f,
+	0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x12, 0x39, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63,
+	0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61,
+	0x6c, 0x4b, 0x65, 0x79, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xc7, 0x01,
+	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x12, 0x33,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x64, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06,
+	0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65,
+	0x79, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x75, 0x6e,
+	0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61,
+	0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0e, 0x70,
+	0x72, 0x75, 0x6e, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x78, 0x48, 0x61, 0x73,
+	0x68, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x64, 0x73,
+	0x22, 0xea, 0x02, 0x0a, 0x0d, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x4d, 0x65,
+	0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65,
+	0x43, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x35, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x64, 0x65, 0x64, 0x42, 0x79, 0x22, 0x71, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64,
+	0x22, 0x92, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63,
+	0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x36, 0x0a,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x61,
+	0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x52,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x38, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x7f, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x62,
+	0x75, 0x69, 0x6c, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73,
+	0x22, 0x13, 0x0a, 0x11, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x71, 0x22, 0x70, 0x0a, 0x11, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75,
+	0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0xa5, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x38,
+	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x8b, 0x01, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x33, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f,
+	0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x64, 0x22, 0xf6, 0x01,
+	0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65,
+	0x79, 0x73, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e,
+	0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x5c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x49, 0x64, 0x22, 0x7f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x12, 0x33,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x61, 0x66, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0xf6, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6f,
+	0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f,
+	0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x70,
+	0x75, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x75, 0x62, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x72, 0x69, 0x76, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x72, 0x69,
+	0x76, 0x12, 0x39, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e,
+	0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c,
+	0x4b, 0x65, 0x79, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xad, 0x01, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xd7, 0x02, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70,
+	0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
+	0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x35, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x47, 0x75,
+	0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x77, 0x0a, 0x0f, 0x53,
+	0x65, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x12, 0x33,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x41, 0x74, 0x22, 0x59, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64,
+	0x69, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22,
+	0x8d, 0x02, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65,
+	0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69,
+	0x61, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x22,
+	0x72, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55,
+	0x75, 0x69, 0x64, 0x22, 0xa7, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x43, 0x61, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x55, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x79, 0x70,
+	0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x74, 0x22, 0x92, 0x01,
+	0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b,
+	0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x04, 0x63,
+	0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x61, 0x76, 0x6f,
+	0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x61, 0x73, 0x65, 0x52, 0x04, 0x63, 0x61,
+	0x73, 0x65, 0x22, 0x72, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61,
+	0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b,
+	0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x43, 0x61, 0x73, 0x65, 0x52, 0x04, 0x63, 0x61, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x73, 0x65, 0x49,
+	0x64, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
+	0x35, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x61, 0x73, 0x65,
+	0x52, 0x04, 0x63, 0x61, 0x73, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x22, 0xb6, 0x01, 0x0a, 0x13, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x65,
+	0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x41, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f,
+	0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x12, 0x35, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43,
+	0x61, 0x73, 0x65, 0x52, 0x04, 0x63, 0x61, 0x73, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x11, 0x53, 0x65,
+	0x74, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69,
+	0x61, 0x72, 0x79, 0x5f, 0x70, 0x75, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62,
+	0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x50, 0x75, 0x62, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x72, 0x6e, 0x5f, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x77, 0x61, 0x72, 0x6e,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x68, 0x65, 0x72,
+	0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x65, 0x6e, 0x65, 0x66,
+	0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x75, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x50, 0x75, 0x62,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x72, 0x6e,
+	0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x77,
+	0x61, 0x72, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x48,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65,
+	0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75,
+	0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x12, 0x42, 0x0a, 0x0b, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x68,
+	0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f,
+	0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x0c,
+	0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76,
+	0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x73, 0x67, 0x12, 0x42, 0x0a, 0x0b, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75,
+	0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x49,
+	0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x69, 0x6e, 0x68, 0x65,
+	0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x5b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x55, 0x75, 0x69, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65,
+	0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75,
+	0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x12, 0x42, 0x0a, 0x0b, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x68,
+	0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x76, 0x0a, 0x0c, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74,
+	0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x5d, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22, 0x9a, 0x01, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b,
+	0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x3c, 0x0a, 0x08, 0x6b,
+	0x65, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79,
+	0x52, 0x07, 0x6b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x66, 0x0a, 0x0a, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x22, 0x80, 0x01, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xce, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x06,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0x82, 0x02, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
+	0x15, 0x0a, 0x06, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x06,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x06, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75,
+	0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x10, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63,
+	0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x15, 0x0a,
+	0x06, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x22, 0x84, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x15, 0x0a, 0x06, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x36, 0x0a,
+	0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x06, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f,
+	0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb9, 0x02, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55,
+	0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70,
+	0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x15,
+	0x0a, 0x06, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0x57, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64,
+	0x22, 0xbb, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52,
+	0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0xbc,
+	0x03, 0x0a, 0x14, 0x53, 0x69, 0x67, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x53, 0x6f, 0x63, 0x69, 0x61,
+	0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f,
+	0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xc6, 0x01,
+	0x0a, 0x14, 0x53, 0x69, 0x67, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63,
+	0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0xe5, 0x03, 0x0a, 0x11, 0x44, 0x65, 0x72, 0x69, 0x76,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x61, 0x73, 0x65,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
+	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63,
+	0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x89,
+	0x01, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x0e, 0x44, 0x65,
+	0x72, 0x69, 0x76, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x0e, 0x44, 0x65,
+	0x72, 0x69, 0x76, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x78, 0x70, 0x75, 0x62, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x78, 0x70, 0x75, 0x62, 0x12, 0x41, 0x0a, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xde,
+	0x01, 0x0a, 0x11, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b,
+	0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x3f, 0x0a, 0x08, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x09,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22,
+	0xeb, 0x03, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x6f, 0x63, 0x69,
+	0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x61,
+	0x73, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x77, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x53, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xcb, 0x01,
+	0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4b, 0x65, 0x79,
+	0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x64, 0x22, 0xdc, 0x01, 0x0a, 0x14,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b,
+	0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x37, 0x0a, 0x04, 0x6b,
+	0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x61, 0x76, 0x6f,
+	0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75,
+	0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe6, 0x01, 0x0a, 0x14, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f,
+	0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x77, 0x5f, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43,
+	0x6f, 0x64, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76,
+	0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x73, 0x67, 0x12, 0x44, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x59, 0x0a, 0x0f, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x22, 0xb1, 0x01, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f,
+	0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x5d, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x70,
+	0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
+	0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x92, 0x02, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x5b, 0x0a, 0x12,
+	0x53, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xe8, 0x01, 0x0a, 0x0f, 0x46, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x0f, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70,
+	0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xd2,
+	0x01, 0x0a, 0x11, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69,
+	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0x7b, 0x0a, 0x11, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
+	0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x41, 0x74,
+	0x22, 0x59, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22, 0xa7, 0x01, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x70, 0x12,
+	0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x69, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0x95, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61,
+	0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x0d, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x54, 0x6f, 0x74, 0x70, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0x80, 0x01, 0x0a, 0x0d, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x6f, 0x74, 0x70, 0x52, 0x65,
+	0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x69, 0x22, 0xb2, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x6f,
+	0x74, 0x70, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f,
+	0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x7a, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x54, 0x6f, 0x74, 0x70, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54,
+	0x6f, 0x74, 0x70, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f,
+	0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x57, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x54, 0x6f, 0x74, 0x70, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75,
+	0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x22, 0x86, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x70,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x74,
+	0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x77, 0x0a, 0x18, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63,
+	0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f,
+	0x64, 0x65, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f,
+	0x64, 0x65, 0x22, 0x7c, 0x0a, 0x15, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x76, 0x6f,
+	0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x22, 0xa4, 0x01, 0x0a, 0x12, 0x49, 0x73, 0x73, 0x75, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x75,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x75,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0xb4, 0x01, 0x0a, 0x12, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x12, 0x33,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x76,
+	0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x76, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x12, 0x33, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x73, 0x61,
+	0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x2a, 0xce,
+	0x01, 0x0a, 0x0a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x53, 0x10, 0x02, 0x12, 0x0d,
+	0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x15, 0x0a,
+	0x11, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x44,
+	0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x4f, 0x43, 0x4b, 0x45,
+	0x44, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x10, 0x07, 0x12,
+	0x14, 0x0a, 0x10, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49,
+	0x52, 0x45, 0x44, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x09, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x52, 0x45, 0x44,
+	0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x10, 0x0a, 0x32,
+	0xab, 0x22, 0x0a, 0x10, 0x4c, 0x65, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e,
+	0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69,
+	0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70,
+	0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x53,
+	0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73, 0x61,
+	0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x70, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0c, 0x67, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c,
+	0x4b, 0x65, 0x79, 0x12, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e,
+	0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f,
+	0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x22,
+	0x00, 0x12, 0x68, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x6f, 0x63, 0x69,
+	0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70,
+	0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a,
+	0x28, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x27,
+	0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61,
+	0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70,
+	0x22, 0x00, 0x12, 0x62, 0x0a, 0x0e, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61,
+	0x6c, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63,
+	0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65,
+	0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x73,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6f,
+	0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x26, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75,
+	0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x26, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61,
+	0x6c, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0c, 0x72, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x2e, 0x73, 0x61, 0x76,
+	0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x1a, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x10, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x28, 0x2e, 0x73,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x70,
+	0x22, 0x00, 0x28, 0x01, 0x12, 0x71, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x73, 0x61,
+	0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75,
+	0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x61,
+	0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65,
+	0x79, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70,
+	0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x70, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x5c, 0x0a, 0x0c, 0x67, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e,
+	0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f,
+	0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x22,
+	0x00, 0x12, 0x5c, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e,
+	0x73, 0x12, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65,
+	0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64,
+	0x69, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x65,
+	0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12,
+	0x5c, 0x0a, 0x0c, 0x67, 0x65, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x12,
+	0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70,
+	0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x47,
+	0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x65, 0x0a,
+	0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x12, 0x27, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x73, 0x61, 0x76, 0x6f,
+	0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x70, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x27, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x1a, 0x27, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0f, 0x67,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x61, 0x73, 0x65, 0x12, 0x27,
+	0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x70,
+	0x22, 0x00, 0x12, 0x68, 0x0a, 0x10, 0x73, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x28, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71,
+	0x1a, 0x28, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0e,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x26,
+	0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x22, 0x00,
+	0x12, 0x62, 0x0a, 0x0e, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x26, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b,
+	0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65,
+	0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x73, 0x61, 0x76,
+	0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x12, 0x21, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65,
+	0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63,
+	0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0e, 0x67, 0x65, 0x74,
+	0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x73, 0x61,
+	0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e,
+	0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x68,
+	0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x68, 0x0a,
+	0x10, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x28, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65,
+	0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65, 0x72,
+	0x69, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x73, 0x61,
+	0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x4b, 0x65,
+	0x79, 0x73, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x73, 0x61,
+	0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70,
+	0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e,
+	0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x73, 0x61, 0x76,
+	0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x70, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x08, 0x67, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12,
+	0x20, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x20, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65,
+	0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e,
+	0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x6b, 0x0a,
+	0x11, 0x73, 0x69, 0x67, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b,
+	0x65, 0x79, 0x12, 0x29, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b,
+	0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e,
+	0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x53, 0x6f, 0x63, 0x69,
+	0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0e, 0x64, 0x65,
+	0x72, 0x69, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x73,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63,
+	0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x6b,
+	0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c,
+	0x4b, 0x65, 0x79, 0x12, 0x29, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e,
+	0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x29,
+	0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x11, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x12, 0x29, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x73, 0x61,
+	0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x29, 0x2e,
+	0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75,
+	0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75,
+	0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x27, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65,
+	0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0c,
+	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x24, 0x2e, 0x73,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b,
+	0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0e, 0x75, 0x6e,
+	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x26, 0x2e, 0x73,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63,
+	0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x5c,
+	0x0a, 0x0c, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x24,
+	0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63,
+	0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x05,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1d, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70,
+	0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63,
+	0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54,
+	0x6f, 0x74, 0x70, 0x12, 0x22, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e,
+	0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x54, 0x6f, 0x74, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x6f, 0x74, 0x70, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x59, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x6f, 0x74, 0x70, 0x12, 0x23, 0x2e, 0x73,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x6f, 0x74, 0x70, 0x52, 0x65,
+	0x71, 0x1a, 0x23, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65,
+	0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54,
+	0x6f, 0x74, 0x70, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x54, 0x6f, 0x74, 0x70, 0x12, 0x23, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x74, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x73,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x74, 0x70, 0x52, 0x65,
+	0x70, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x15, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x73,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x73, 0x61,
+	0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x12,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x2a, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b,
+	0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2a,
+	0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0f,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x27, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61,
+	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75,
+	0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x70, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x10, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x28, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65,
+	0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x6f,
+	0x63, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x22, 0x00, 0x32, 0xd8, 0x04,
+	0x0a, 0x10, 0x4b, 0x65, 0x79, 0x41, 0x64, 0x61, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x12, 0x5c, 0x0a, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x26,
+	0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x70, 0x22, 0x00,
+	0x12, 0x5f, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e,
+	0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f,
+	0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x22,
+	0x00, 0x12, 0x5c, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65,
+	0x79, 0x12, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65,
+	0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61,
+	0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x65,
+	0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12,
+	0x5c, 0x0a, 0x0c, 0x67, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12,
+	0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70,
+	0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x22, 0x00, 0x12, 0x65, 0x0a,
+	0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79,
+	0x12, 0x27, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x73, 0x61, 0x76, 0x6f,
+	0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x70, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0e, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x53, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x26,
+	0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x22, 0x00, 0x42, 0x2b, 0x0a, 0x16, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x73, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x2e, 0x6b, 0x65, 0x79, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x5a, 0x11, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6b, 0x65, 0x79, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_keylocker_proto_rawDescOnce sync.Once
+	file_proto_keylocker_proto_rawDescData = file_proto_keylocker_proto_rawDesc
+)
+
+func file_proto_keylocker_proto_rawDescGZIP() []byte {
+	file_proto_keylocker_proto_rawDescOnce.Do(func() {
+		file_proto_keylocker_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_keylocker_proto_rawDescData)
+	})
+	return file_proto_keylocker_proto_rawDescData
+}
+
+var file_proto_keylocker_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_keylocker_proto_msgTypes = make([]protoimpl.MessageInfo, 103)
+var file_proto_keylocker_proto_goTypes = []interface{}{
+	(ReturnCode)(0),                  // 0: savourrpc.keylocker.ReturnCode
+	(*SocialKey)(nil),                // 1: savourrpc.keylocker.SocialKey
+	(*SupportChainReq)(nil),          // 2: savourrpc.keylocker.SupportChainReq
+	(*SupportChainRep)(nil),          // 3: savourrpc.keylocker.SupportChainRep
+	(*SetSocialKeyReq)(nil),          // 4: savourrpc.keylocker.SetSocialKeyReq
+	(*SetSocialKeyRep)(nil),          // 5: savourrpc.keylocker.SetSocialKeyRep
+	(*GetSocialKeyReq)(nil),          // 6: savourrpc.keylocker.GetSocialKeyReq
+	(*GetSocialKeyRep)(nil),          // 7: savourrpc.keylocker.GetSocialKeyRep
+	(*RecoverSocialKeyReq)(nil),      // 8: savourrpc.keylocker.RecoverSocialKeyReq
+	(*RecoverSocialKeyRep)(nil),      // 9: savourrpc.keylocker.RecoverSocialKeyRep
+	(*DeleteSocialKeyReq)(nil),       // 10: savourrpc.keylocker.DeleteSocialKeyReq
+	(*DeleteSocialKeyRep)(nil),       // 11: savourrpc.keylocker.DeleteSocialKeyRep
+	(*PruneSocialKeyReq)(nil),        // 12: savourrpc.keylocker.PruneSocialKeyReq
+	(*PruneSocialKeyRep)(nil),        // 13: savourrpc.keylocker.PruneSocialKeyRep
+	(*SocialKeyMeta)(nil),            // 14: savourrpc.keylocker.SocialKeyMeta
+	(*ListSocialKeysReq)(nil),        // 15: savourrpc.keylocker.ListSocialKeysReq
+	(*ListSocialKeysRep)(nil),        // 16: savourrpc.keylocker.ListSocialKeysRep
+	(*ReloadConfigReq)(nil),          // 17: savourrpc.keylocker.ReloadConfigReq
+	(*ReloadConfigRep)(nil),          // 18: savourrpc.keylocker.ReloadConfigRep
+	(*PluginDescribeReq)(nil),        // 19: savourrpc.keylocker.PluginDescribeReq
+	(*PluginDescribeRep)(nil),        // 20: savourrpc.keylocker.PluginDescribeRep
+	(*ImportSocialKeysReq)(nil),      // 21: savourrpc.keylocker.ImportSocialKeysReq
+	(*ImportSocialKeyResult)(nil),    // 22: savourrpc.keylocker.ImportSocialKeyResult
+	(*ImportSocialKeysRep)(nil),      // 23: savourrpc.keylocker.ImportSocialKeysRep
+	(*GetImportCheckpointReq)(nil),   // 24: savourrpc.keylocker.GetImportCheckpointReq
+	(*GetImportCheckpointRep)(nil),   // 25: savourrpc.keylocker.GetImportCheckpointRep
+	(*ExportSocialKeysReq)(nil),      // 26: savourrpc.keylocker.ExportSocialKeysReq
+	(*ExportSocialKeysRep)(nil),      // 27: savourrpc.keylocker.ExportSocialKeysRep
+	(*GetJobStatusReq)(nil),          // 28: savourrpc.keylocker.GetJobStatusReq
+	(*GetJobStatusRep)(nil),          // 29: savourrpc.keylocker.GetJobStatusRep
+	(*SetGuardiansReq)(nil),          // 30: savourrpc.keylocker.SetGuardiansReq
+	(*SetGuardiansRep)(nil),          // 31: savourrpc.keylocker.SetGuardiansRep
+	(*GetGuardiansReq)(nil),          // 32: savourrpc.keylocker.GetGuardiansReq
+	(*GetGuardiansRep)(nil),          // 33: savourrpc.keylocker.GetGuardiansRep
+	(*RequestRecoveryReq)(nil),       // 34: savourrpc.keylocker.RequestRecoveryReq
+	(*RecoveryCase)(nil),             // 35: savourrpc.keylocker.RecoveryCase
+	(*RequestRecoveryRep)(nil),       // 36: savourrpc.keylocker.RequestRecoveryRep
+	(*ApproveRecoveryReq)(nil),       // 37: savourrpc.keylocker.ApproveRecoveryReq
+	(*ApproveRecoveryRep)(nil),       // 38: savourrpc.keylocker.ApproveRecoveryRep
+	(*GetRecoveryCaseReq)(nil),       // 39: savourrpc.keylocker.GetRecoveryCaseReq
+	(*GetRecoveryCaseRep)(nil),       // 40: savourrpc.keylocker.GetRecoveryCaseRep
+	(*SetRecoveryDelayReq)(nil),      // 41: savourrpc.keylocker.SetRecoveryDelayReq
+	(*SetRecoveryDelayRep)(nil),      // 42: savourrpc.keylocker.SetRecoveryDelayRep
+	(*CancelRecoveryReq)(nil),        // 43: savourrpc.keylocker.CancelRecoveryReq
+	(*CancelRecoveryRep)(nil),        // 44: savourrpc.keylocker.CancelRecoveryRep
+	(*SetInheritanceReq)(nil),        // 45: savourrpc.keylocker.SetInheritanceReq
+	(*Inheritance)(nil),              // 46: savourrpc.keylocker.Inheritance
+	(*SetInheritanceRep)(nil),        // 47: savourrpc.keylocker.SetInheritanceRep
+	(*HeartbeatReq)(nil),             // 48: savourrpc.keylocker.HeartbeatReq
+	(*HeartbeatRep)(nil),             // 49: savourrpc.keylocker.HeartbeatRep
+	(*GetInheritanceReq)(nil),        // 50: savourrpc.keylocker.GetInheritanceReq
+	(*GetInheritanceRep)(nil),        // 51: savourrpc.keylocker.GetInheritanceRep
+	(*InheritedKey)(nil),             // 52: savourrpc.keylocker.InheritedKey
+	(*GetInheritedKeysReq)(nil),      // 53: savourrpc.keylocker.GetInheritedKeysReq
+	(*GetInheritedKeysRep)(nil),      // 54: savourrpc.keylocker.GetInheritedKeysRep
+	(*ShareInput)(nil),               // 55: savourrpc.keylocker.ShareInput
+	(*ShareMeta)(nil),                // 56: savourrpc.keylocker.ShareMeta
+	(*StoreSharesReq)(nil),           // 57: savourrpc.keylocker.StoreSharesReq
+	(*StoreSharesRep)(nil),           // 58: savourrpc.keylocker.StoreSharesRep
+	(*RefreshSharesReq)(nil),         // 59: savourrpc.keylocker.RefreshSharesReq
+	(*RefreshSharesRep)(nil),         // 60: savourrpc.keylocker.RefreshSharesRep
+	(*GetShareReq)(nil),              // 61: savourrpc.keylocker.GetShareReq
+	(*GetShareRep)(nil),              // 62: savourrpc.keylocker.GetShareRep
+	(*ListSharesReq)(nil),            // 63: savourrpc.keylocker.ListSharesReq
+	(*ListSharesRep)(nil),            // 64: savourrpc.keylocker.ListSharesRep
+	(*SignWithSocialKeyReq)(nil),     // 65: savourrpc.keylocker.SignWithSocialKeyReq
+	(*SignWithSocialKeyRep)(nil),     // 66: savourrpc.keylocker.SignWithSocialKeyRep
+	(*DeriveAccountsReq)(nil),        // 67: savourrpc.keylocker.DeriveAccountsReq
+	(*AccountQuery)(nil),             // 68: savourrpc.keylocker.AccountQuery
+	(*DerivedAddress)(nil),           // 69: savourrpc.keylocker.DerivedAddress
+	(*DerivedAccount)(nil),           // 70: savourrpc.keylocker.DerivedAccount
+	(*DeriveAccountsRep)(nil),        // 71: savourrpc.keylocker.DeriveAccountsRep
+	(*TransferSocialKeyReq)(nil),     // 72: savourrpc.keylocker.TransferSocialKeyReq
+	(*TransferredKey)(nil),           // 73: savourrpc.keylocker.TransferredKey
+	(*TransferSocialKeyRep)(nil),     // 74: savourrpc.keylocker.TransferSocialKeyRep
+	(*ChangeCredentialsReq)(nil),     // 75: savourrpc.keylocker.ChangeCredentialsReq
+	(*ChangeCredentialsRep)(nil),     // 76: savourrpc.keylocker.ChangeCredentialsRep
+	(*PolicyViolation)(nil),          // 77: savourrpc.keylocker.PolicyViolation
+	(*VerifyCredentialsReq)(nil),     // 78: savourrpc.keylocker.VerifyCredentialsReq
+	(*VerifyCredentialsRep)(nil),     // 79: savourrpc.keylocker.VerifyCredentialsRep
+	(*SetOwnerAddressReq)(nil),       // 80: savourrpc.keylocker.SetOwnerAddressReq
+	(*SetOwnerAddressRep)(nil),       // 81: savourrpc.keylocker.SetOwnerAddressRep
+	(*FreezeWalletReq)(nil),          // 82: savourrpc.keylocker.FreezeWalletReq
+	(*FreezeWalletRep)(nil),          // 83: savourrpc.keylocker.FreezeWalletRep
+	(*UnfreezeWalletReq)(nil),        // 84: savourrpc.keylocker.UnfreezeWalletReq
+	(*UnfreezeWalletRep)(nil),        // 85: savourrpc.keylocker.UnfreezeWalletRep
+	(*GetChallengeReq)(nil),          // 86: savourrpc.keylocker.GetChallengeReq
+	(*GetChallengeRep)(nil),          // 87: savourrpc.keylocker.GetChallengeRep
+	(*LoginReq)(nil),                 // 88: savourrpc.keylocker.LoginReq
+	(*LoginRep)(nil),                 // 89: savourrpc.keylocker.LoginRep
+	(*EnrollTotpReq)(nil),            // 90: savourrpc.keylocker.EnrollTotpReq
+	(*EnrollTotpRep)(nil),            // 91: savourrpc.keylocker.EnrollTotpRep
+	(*ConfirmTotpReq)(nil),           // 92: savourrpc.keylocker.ConfirmTotpReq
+	(*ConfirmTotpRep)(nil),           // 93: savourrpc.keylocker.ConfirmTotpRep
+	(*DisableTotpReq)(nil),           // 94: savourrpc.keylocker.DisableTotpReq
+	(*DisableTotpRep)(nil),           // 95: savourrpc.keylocker.DisableTotpRep
+	(*GenerateRecoveryCodesReq)(nil), // 96: savourrpc.keylocker.GenerateRecoveryCodesReq
+	(*GenerateRecoveryCodesRep)(nil), // 97: savourrpc.keylocker.GenerateRecoveryCodesRep
+	(*CountRecoveryCodesReq)(nil),    // 98: savourrpc.keylocker.CountRecoveryCodesReq
+	(*CountRecoveryCodesRep)(nil),    // 99: savourrpc.keylocker.CountRecoveryCodesRep
+	(*IssueSocialCodeReq)(nil),       // 100: savourrpc.keylocker.IssueSocialCodeReq
+	(*IssueSocialCodeRep)(nil),       // 101: savourrpc.keylocker.IssueSocialCodeRep
+	(*RevokeSocialCodeReq)(nil),      // 102: savourrpc.keylocker.RevokeSocialCodeReq
+	(*RevokeSocialCodeRep)(nil),      // 103: savourrpc.keylocker.RevokeSocialCodeRep
+}
+var file_proto_keylocker_proto_depIdxs = []int32{
+	0,   // 0: savourrpc.keylocker.SupportChainRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 1: savourrpc.keylocker.SetSocialKeyRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	77,  // 2: savourrpc.keylocker.SetSocialKeyRep.violations:type_name -> savourrpc.keylocker.PolicyViolation
+	0,   // 3: savourrpc.keylocker.GetSocialKeyRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	1,   // 4: savourrpc.keylocker.GetSocialKeyRep.key_list:type_name -> savourrpc.keylocker.SocialKey
+	0,   // 5: savourrpc.keylocker.RecoverSocialKeyRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	1,   // 6: savourrpc.keylocker.RecoverSocialKeyRep.key_list:type_name -> savourrpc.keylocker.SocialKey
+	0,   // 7: savourrpc.keylocker.DeleteSocialKeyRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 8: savourrpc.keylocker.PruneSocialKeyRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 9: savourrpc.keylocker.ListSocialKeysRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	14,  // 10: savourrpc.keylocker.ListSocialKeysRep.keys:type_name -> savourrpc.keylocker.SocialKeyMeta
+	0,   // 11: savourrpc.keylocker.ReloadConfigRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 12: savourrpc.keylocker.PluginDescribeRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	4,   // 13: savourrpc.keylocker.ImportSocialKeysReq.item:type_name -> savourrpc.keylocker.SetSocialKeyReq
+	0,   // 14: savourrpc.keylocker.ImportSocialKeyResult.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 15: savourrpc.keylocker.ImportSocialKeysRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	22,  // 16: savourrpc.keylocker.ImportSocialKeysRep.results:type_name -> savourrpc.keylocker.ImportSocialKeyResult
+	0,   // 17: savourrpc.keylocker.GetImportCheckpointRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 18: savourrpc.keylocker.ExportSocialKeysRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	1,   // 19: savourrpc.keylocker.ExportSocialKeysRep.key_list:type_name -> savourrpc.keylocker.SocialKey
+	0,   // 20: savourrpc.keylocker.GetJobStatusRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 21: savourrpc.keylocker.SetGuardiansRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 22: savourrpc.keylocker.GetGuardiansRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 23: savourrpc.keylocker.RequestRecoveryRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	35,  // 24: savourrpc.keylocker.RequestRecoveryRep.case:type_name -> savourrpc.keylocker.RecoveryCase
+	0,   // 25: savourrpc.keylocker.ApproveRecoveryRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	35,  // 26: savourrpc.keylocker.ApproveRecoveryRep.case:type_name -> savourrpc.keylocker.RecoveryCase
+	0,   // 27: savourrpc.keylocker.GetRecoveryCaseRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	35,  // 28: savourrpc.keylocker.GetRecoveryCaseRep.case:type_name -> savourrpc.keylocker.RecoveryCase
+	0,   // 29: savourrpc.keylocker.SetRecoveryDelayRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 30: savourrpc.keylocker.CancelRecoveryRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	35,  // 31: savourrpc.keylocker.CancelRecoveryRep.case:type_name -> savourrpc.keylocker.RecoveryCase
+	0,   // 32: savourrpc.keylocker.SetInheritanceRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	46,  // 33: savourrpc.keylocker.SetInheritanceRep.inheritance:type_name -> savourrpc.keylocker.Inheritance
+	0,   // 34: savourrpc.keylocker.HeartbeatRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	46,  // 35: savourrpc.keylocker.HeartbeatRep.inheritance:type_name -> savourrpc.keylocker.Inheritance
+	0,   // 36: savourrpc.keylocker.GetInheritanceRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	46,  // 37: savourrpc.keylocker.GetInheritanceRep.inheritance:type_name -> savourrpc.keylocker.Inheritance
+	0,   // 38: savourrpc.keylocker.GetInheritedKeysRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	52,  // 39: savourrpc.keylocker.GetInheritedKeysRep.key_list:type_name -> savourrpc.keylocker.InheritedKey
+	55,  // 40: savourrpc.keylocker.StoreSharesReq.shares:type_name -> savourrpc.keylocker.ShareInput
+	0,   // 41: savourrpc.keylocker.StoreSharesRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	56,  // 42: savourrpc.keylocker.StoreSharesRep.shares:type_name -> savourrpc.keylocker.ShareMeta
+	77,  // 43: savourrpc.keylocker.StoreSharesRep.violations:type_name -> savourrpc.keylocker.PolicyViolation
+	55,  // 44: savourrpc.keylocker.RefreshSharesReq.shares:type_name -> savourrpc.keylocker.ShareInput
+	0,   // 45: savourrpc.keylocker.RefreshSharesRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	56,  // 46: savourrpc.keylocker.RefreshSharesRep.shares:type_name -> savourrpc.keylocker.ShareMeta
+	77,  // 47: savourrpc.keylocker.RefreshSharesRep.violations:type_name -> savourrpc.keylocker.PolicyViolation
+	0,   // 48: savourrpc.keylocker.GetShareRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 49: savourrpc.keylocker.ListSharesRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	56,  // 50: savourrpc.keylocker.ListSharesRep.shares:type_name -> savourrpc.keylocker.ShareMeta
+	0,   // 51: savourrpc.keylocker.SignWithSocialKeyRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	68,  // 52: savourrpc.keylocker.DeriveAccountsReq.accounts:type_name -> savourrpc.keylocker.AccountQuery
+	69,  // 53: savourrpc.keylocker.DerivedAccount.addresses:type_name -> savourrpc.keylocker.DerivedAddress
+	0,   // 54: savourrpc.keylocker.DeriveAccountsRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	70,  // 55: savourrpc.keylocker.DeriveAccountsRep.accounts:type_name -> savourrpc.keylocker.DerivedAccount
+	69,  // 56: savourrpc.keylocker.DeriveAccountsRep.addresses:type_name -> savourrpc.keylocker.DerivedAddress
+	0,   // 57: savourrpc.keylocker.TransferSocialKeyRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	73,  // 58: savourrpc.keylocker.TransferSocialKeyRep.keys:type_name -> savourrpc.keylocker.TransferredKey
+	77,  // 59: savourrpc.keylocker.TransferSocialKeyRep.violations:type_name -> savourrpc.keylocker.PolicyViolation
+	0,   // 60: savourrpc.keylocker.ChangeCredentialsRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	77,  // 61: savourrpc.keylocker.ChangeCredentialsRep.violations:type_name -> savourrpc.keylocker.PolicyViolation
+	0,   // 62: savourrpc.keylocker.VerifyCredentialsRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 63: savourrpc.keylocker.SetOwnerAddressRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 64: savourrpc.keylocker.FreezeWalletRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 65: savourrpc.keylocker.UnfreezeWalletRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 66: savourrpc.keylocker.GetChallengeRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 67: savourrpc.keylocker.LoginRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 68: savourrpc.keylocker.EnrollTotpRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 69: savourrpc.keylocker.ConfirmTotpRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 70: savourrpc.keylocker.DisableTotpRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 71: savourrpc.keylocker.GenerateRecoveryCodesRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 72: savourrpc.keylocker.CountRecoveryCodesRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 73: savourrpc.keylocker.IssueSocialCodeRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	0,   // 74: savourrpc.keylocker.RevokeSocialCodeRep.code:type_name -> savourrpc.keylocker.ReturnCode
+	2,   // 75: savourrpc.keylocker.LeyLockerService.getSupportChain:input_type -> savourrpc.keylocker.SupportChainReq
+	4,   // 76: savourrpc.keylocker.LeyLockerService.setSocialKey:input_type -> savourrpc.keylocker.SetSocialKeyReq
+	6,   // 77: savourrpc.keylocker.LeyLockerService.getSocialKey:input_type -> savourrpc.keylocker.GetSocialKeyReq
+	8,   // 78: savourrpc.keylocker.LeyLockerService.recoverSocialKey:input_type -> savourrpc.keylocker.RecoverSocialKeyReq
+	10,  // 79: savourrpc.keylocker.LeyLockerService.deleteSocialKey:input_type -> savourrpc.keylocker.DeleteSocialKeyReq
+	12,  // 80: savourrpc.keylocker.LeyLockerService.pruneSocialKey:input_type -> savourrpc.keylocker.PruneSocialKeyReq
+	15,  // 81: savourrpc.keylocker.LeyLockerService.listSocialKeys:input_type -> savourrpc.keylocker.ListSocialKeysReq
+	17,  // 82: savourrpc.keylocker.LeyLockerService.reloadConfig:input_type -> savourrpc.keylocker.ReloadConfigReq
+	21,  // 83: savourrpc.keylocker.LeyLockerService.importSocialKeys:input_type -> savourrpc.keylocker.ImportSocialKeysReq
+	24,  // 84: savourrpc.keylocker.LeyLockerService.getImportCheckpoint:input_type -> savourrpc.keylocker.GetImportCheckpointReq
+	26,  // 85: savourrpc.keylocker.LeyLockerService.exportSocialKeys:input_type -> savourrpc.keylocker.ExportSocialKeysReq
+	28,  // 86: savourrpc.keylocker.LeyLockerService.getJobStatus:input_type -> savourrpc.keylocker.GetJobStatusReq
+	30,  // 87: savourrpc.keylocker.LeyLockerService.setGuardians:input_type -> savourrpc.keylocker.SetGuardiansReq
+	32,  // 88: savourrpc.keylocker.LeyLockerService.getGuardians:input_type -> savourrpc.keylocker.GetGuardiansReq
+	34,  // 89: savourrpc.keylocker.LeyLockerService.requestRecovery:input_type -> savourrpc.keylocker.RequestRecoveryReq
+	37,  // 90: savourrpc.keylocker.LeyLockerService.approveRecovery:input_type -> savourrpc.keylocker.ApproveRecoveryReq
+	39,  // 91: savourrpc.keylocker.LeyLockerService.getRecoveryCase:input_type -> savourrpc.keylocker.GetRecoveryCaseReq
+	41,  // 92: savourrpc.keylocker.LeyLockerService.setRecoveryDelay:input_type -> savourrpc.keylocker.SetRecoveryDelayReq
+	43,  // 93: savourrpc.keylocker.LeyLockerService.cancelRecovery:input_type -> savourrpc.keylocker.CancelRecoveryReq
+	45,  // 94: savourrpc.keylocker.LeyLockerService.setInheritance:input_type -> savourrpc.keylocker.SetInheritanceReq
+	48,  // 95: savourrpc.keylocker.LeyLockerService.heartbeat:input_type -> savourrpc.keylocker.HeartbeatReq
+	50,  // 96: savourrpc.keylocker.LeyLockerService.getInheritance:input_type -> savourrpc.keylocker.GetInheritanceReq
+	53,  // 97: savourrpc.keylocker.LeyLockerService.getInheritedKeys:input_type -> savourrpc.keylocker.GetInheritedKeysReq
+	57,  // 98: savourrpc.keylocker.LeyLockerService.storeShares:input_type -> savourrpc.keylocker.StoreSharesReq
+	59,  // 99: savourrpc.keylocker.LeyLockerService.refreshShares:input_type -> savourrpc.keylocker.RefreshSharesReq
+	61,  // 100: savourrpc.keylocker.LeyLockerService.getShare:input_type -> savourrpc.keylocker.GetShareReq
+	63,  // 101: savourrpc.keylocker.LeyLockerService.listShares:input_type -> savourrpc.keylocker.ListSharesReq
+	65,  // 102: savourrpc.keylocker.LeyLockerService.signWithSocialKey:input_type -> savourrpc.keylocker.SignWithSocialKeyReq
+	67,  // 103: savourrpc.keylocker.LeyLockerService.deriveAccounts:input_type -> savourrpc.keylocker.DeriveAccountsReq
+	72,  // 104: savourrpc.keylocker.LeyLockerService.transferSocialKey:input_type -> savourrpc.keylocker.TransferSocialKeyReq
+	75,  // 105: savourrpc.keylocker.LeyLockerService.changeCredentials:input_type -> savourrpc.keylocker.ChangeCredentialsReq
+	78,  // 106: savourrpc.keylocker.LeyLockerService.verifyCredentials:input_type -> savourrpc.keylocker.VerifyCredentialsReq
+	80,  // 107: savourrpc.keylocker.LeyLockerService.setOwnerAddress:input_type -> savourrpc.keylocker.SetOwnerAddressReq
+	82,  // 108: savourrpc.keylocker.LeyLockerService.freezeWallet:input_type -> savourrpc.keylocker.FreezeWalletReq
+	84,  // 109: savourrpc.keylocker.LeyLockerService.unfreezeWallet:input_type -> savourrpc.keylocker.UnfreezeWalletReq
+	86,  // 110: savourrpc.keylocker.LeyLockerService.getChallenge:input_type -> savourrpc.keylocker.GetChallengeReq
+	88,  // 111: savourrpc.keylocker.LeyLockerService.login:input_type -> savourrpc.keylocker.LoginReq
+	90,  // 112: savourrpc.keylocker.LeyLockerService.enrollTotp:input_type -> savourrpc.keylocker.EnrollTotpReq
+	92,  // 113: savourrpc.keylocker.LeyLockerService.confirmTotp:input_type -> savourrpc.keylocker.ConfirmTotpReq
+	94,  // 114: savourrpc.keylocker.LeyLockerService.disableTotp:input_type -> savourrpc.keylocker.DisableTotpReq
+	96,  // 115: savourrpc.keylocker.LeyLockerService.generateRecoveryCodes:input_type -> savourrpc.keylocker.GenerateRecoveryCodesReq
+	98,  // 116: savourrpc.keylocker.LeyLockerService.countRecoveryCodes:input_type -> savourrpc.keylocker.CountRecoveryCodesReq
+	100, // 117: savourrpc.keylocker.LeyLockerService.issueSocialCode:input_type -> savourrpc.keylocker.IssueSocialCodeReq
+	102, // 118: savourrpc.keylocker.LeyLockerService.revokeSocialCode:input_type -> savourrpc.keylocker.RevokeSocialCodeReq
+	19,  // 119: savourrpc.keylocker.KeyAdaptorPlugin.describe:input_type -> savourrpc.keylocker.PluginDescribeReq
+	2,   // 120: savourrpc.keylocker.KeyAdaptorPlugin.getSupportChain:input_type -> savourrpc.keylocker.SupportChainReq
+	4,   // 121: savourrpc.keylocker.KeyAdaptorPlugin.setSocialKey:input_type -> savourrpc.keylocker.SetSocialKeyReq
+	6,   // 122: savourrpc.keylocker.KeyAdaptorPlugin.getSocialKey:input_type -> savourrpc.keylocker.GetSocialKeyReq
+	10,  // 123: savourrpc.keylocker.KeyAdaptorPlugin.deleteSocialKey:input_type -> savourrpc.keylocker.DeleteSocialKeyReq
+	12,  // 124: savourrpc.keylocker.KeyAdaptorPlugin.pruneSocialKey:input_type -> savourrpc.keylocker.PruneSocialKeyReq
+	3,   // 125: savourrpc.keylocker.LeyLockerService.getSupportChain:output_type -> savourrpc.keylocker.SupportChainRep
+	5,   // 126: savourrpc.keylocker.LeyLockerService.setSocialKey:output_type -> savourrpc.keylocker.SetSocialKeyRep
+	7,   // 127: savourrpc.keylocker.LeyLockerService.getSocialKey:output_type -> savourrpc.keylocker.GetSocialKeyRep
+	9,   // 128: savourrpc.keylocker.LeyLockerService.recoverSocialKey:output_type -> savourrpc.keylocker.RecoverSocialKeyRep
+	11,  // 129: savourrpc.keylocker.LeyLockerService.deleteSocialKey:output_type -> savourrpc.keylocker.DeleteSocialKeyRep
+	13,  // 130: savourrpc.keylocker.LeyLockerService.pruneSocialKey:output_type -> savourrpc.keylocker.PruneSocialKeyRep
+	16,  // 131: savourrpc.keylocker.LeyLockerService.listSocialKeys:output_type -> savourrpc.keylocker.ListSocialKeysRep
+	18,  // 132: savourrpc.keylocker.LeyLockerService.reloadConfig:output_type -> savourrpc.keylocker.ReloadConfigRep
+	23,  // 133: savourrpc.keylocker.LeyLockerService.importSocialKeys:output_type -> savourrpc.keylocker.ImportSocialKeysRep
+	25,  // 134: savourrpc.keylocker.LeyLockerService.getImportCheckpoint:output_type -> savourrpc.keylocker.GetImportCheckpointRep
+	27,  // 135: savourrpc.keylocker.LeyLockerService.exportSocialKeys:output_type -> savourrpc.keylocker.ExportSocialKeysRep
+	29,  // 136: savourrpc.keylocker.LeyLockerService.getJobStatus:output_type -> savourrpc.keylocker.GetJobStatusRep
+	31,  // 137: savourrpc.keylocker.LeyLockerService.setGuardians:output_type -> savourrpc.keylocker.SetGuardiansRep
+	33,  // 138: savourrpc.keylocker.LeyLockerService.getGuardians:output_type -> savourrpc.keylocker.GetGuardiansRep
+	36,  // 139: savourrpc.keylocker.LeyLockerService.requestRecovery:output_type -> savourrpc.keylocker.RequestRecoveryRep
+	38,  // 140: savourrpc.keylocker.LeyLockerService.approveRecovery:output_type -> savourrpc.keylocker.ApproveRecoveryRep
+	40,  // 141: savourrpc.keylocker.LeyLockerService.getRecoveryCase:output_type -> savourrpc.keylocker.GetRecoveryCaseRep
+	42,  // 142: savourrpc.keylocker.LeyLockerService.setRecoveryDelay:output_type -> savourrpc.keylocker.SetRecoveryDelayRep
+	44,  // 143: savourrpc.keylocker.LeyLockerService.cancelRecovery:output_type -> savourrpc.keylocker.CancelRecoveryRep
+	47,  // 144: savourrpc.keylocker.LeyLockerService.setInheritance:output_type -> savourrpc.keylocker.SetInheritanceRep
+	49,  // 145: savourrpc.keylocker.LeyLockerService.heartbeat:output_type -> savourrpc.keylocker.HeartbeatRep
+	51,  // 146: savourrpc.keylocker.LeyLockerService.getInheritance:output_type -> savourrpc.keylocker.GetInheritanceRep
+	54,  // 147: savourrpc.keylocker.LeyLockerService.getInheritedKeys:output_type -> savourrpc.keylocker.GetInheritedKeysRep
+	58,  // 148: savourrpc.keylocker.LeyLockerService.storeShares:output_type -> savourrpc.keylocker.StoreSharesRep
+	60,  // 149: savourrpc.keylocker.LeyLockerService.refreshShares:output_type -> savourrpc.keylocker.RefreshSharesRep
+	62,  // 150: savourrpc.keylocker.LeyLockerService.getShare:output_type -> savourrpc.keylocker.GetShareRep
+	64,  // 151: savourrpc.keylocker.LeyLockerService.listShares:output_type -> savourrpc.keylocker.ListSharesRep
+	66,  // 152: savourrpc.keylocker.LeyLockerService.signWithSocialKey:output_type -> savourrpc.keylocker.SignWithSocialKeyRep
+	71,  // 153: savourrpc.keylocker.LeyLockerService.deriveAccounts:output_type -> savourrpc.keylocker.DeriveAccountsRep
+	74,  // 154: savourrpc.keylocker.LeyLockerService.transferSocialKey:output_type -> savourrpc.keylocker.TransferSocialKeyRep
+	76,  // 155: savourrpc.keylocker.LeyLockerService.changeCredentials:output_type -> savourrpc.keylocker.ChangeCredentialsRep
+	79,  // 156: savourrpc.keylocker.LeyLockerService.verifyCredentials:output_type -> savourrpc.keylocker.VerifyCredentialsRep
+	81,  // 157: savourrpc.keylocker.LeyLockerService.setOwnerAddress:output_type -> savourrpc.keylocker.SetOwnerAddressRep
+	83,  // 158: savourrpc.keylocker.LeyLockerService.freezeWallet:output_type -> savourrpc.keylocker.FreezeWalletRep
+	85,  // 159: savourrpc.keylocker.LeyLockerService.unfreezeWallet:output_type -> savourrpc.keylocker.UnfreezeWalletRep
+	87,  // 160: savourrpc.keylocker.LeyLockerService.getChallenge:output_type -> savourrpc.keylocker.GetChallengeRep
+	89,  // 161: savourrpc.keylocker.LeyLockerService.login:output_type -> savourrpc.keylocker.LoginRep
+	91,  // 162: savourrpc.keylocker.LeyLockerService.enrollTotp:output_type -> savourrpc.keylocker.EnrollTotpRep
+	93,  // 163: savourrpc.keylocker.LeyLockerService.confirmTotp:output_type -> savourrpc.keylocker.ConfirmTotpRep
+	95,  // 164: savourrpc.keylocker.LeyLockerService.disableTotp:output_type -> savourrpc.keylocker.DisableTotpRep
+	97,  // 165: savourrpc.keylocker.LeyLockerService.generateRecoveryCodes:output_type -> savourrpc.keylocker.GenerateRecoveryCodesRep
+	99,  // 166: savourrpc.keylocker.LeyLockerService.countRecoveryCodes:output_type -> savourrpc.keylocker.CountRecoveryCodesRep
+	101, // 167: savourrpc.keylocker.LeyLockerService.issueSocialCode:output_type -> savourrpc.keylocker.IssueSocialCodeRep
+	103, // 168: savourrpc.keylocker.LeyLockerService.revokeSocialCode:output_type -> savourrpc.keylocker.RevokeSocialCodeRep
+	20,  // 169: savourrpc.keylocker.KeyAdaptorPlugin.describe:output_type -> savourrpc.keylocker.PluginDescribeRep
+	3,   // 170: savourrpc.keylocker.KeyAdaptorPlugin.getSupportChain:output_type -> savourrpc.keylocker.SupportChainRep
+	5,   // 171: savourrpc.keylocker.KeyAdaptorPlugin.setSocialKey:output_type -> savourrpc.keylocker.SetSocialKeyRep
+	7,   // 172: savourrpc.keylocker.KeyAdaptorPlugin.getSocialKey:output_type -> savourrpc.keylocker.GetSocialKeyRep
+	11,  // 173: savourrpc.keylocker.KeyAdaptorPlugin.deleteSocialKey:output_type -> savourrpc.keylocker.DeleteSocialKeyRep
+	13,  // 174: savourrpc.keylocker.KeyAdaptorPlugin.pruneSocialKey:output_type -> savourrpc.keylocker.PruneSocialKeyRep
+	125, // [125:175] is the sub-list for method output_type
+	75,  // [75:125] is the sub-list for method input_type
+	75,  // [75:75] is the sub-list for extension type_name
+	75,  // [75:75] is the sub-list for extension extendee
+	0,   // [0:75] is the sub-list for field type_name
+}
+
+func init() { file_proto_keylocker_proto_init() }
+func file_proto_keylocker_proto_init() {
+	if File_proto_keylocker_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_keylocker_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SocialKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SupportChainReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SupportChainRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSocialKeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSocialKeyRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSocialKeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSocialKeyRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverSocialKeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverSocialKeyRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSocialKeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSocialKeyRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneSocialKeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneSocialKeyRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SocialKeyMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSocialKeysReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSocialKeysRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadConfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadConfigRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginDescribeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginDescribeRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSocialKeysReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSocialKeyResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSocialKeysRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImportCheckpointReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImportCheckpointRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportSocialKeysReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportSocialKeysRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobStatusRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGuardiansReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGuardiansRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGuardiansReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGuardiansRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestRecoveryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryCase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestRecoveryRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveRecoveryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveRecoveryRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecoveryCaseReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecoveryCaseRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRecoveryDelayReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRecoveryDelayRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelRecoveryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelRecoveryRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetInheritanceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Inheritance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetInheritanceRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInheritanceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInheritanceRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InheritedKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInheritedKeysReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInheritedKeysRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreSharesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreSharesRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshSharesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshSharesRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetShareReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetShareRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSharesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSharesRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignWithSocialKeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignWithSocialKeyRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeriveAccountsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DerivedAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DerivedAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeriveAccountsRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferSocialKeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferredKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferSocialKeyRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeCredentialsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeCredentialsRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCredentialsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCredentialsRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOwnerAddressReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOwnerAddressRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreezeWalletReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreezeWalletRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfreezeWalletReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfreezeWalletRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChallengeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChallengeRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollTotpReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollTotpRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmTotpReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmTotpRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableTotpReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableTotpRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateRecoveryCodesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateRecoveryCodesRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountRecoveryCodesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountRecoveryCodesRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueSocialCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueSocialCodeRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeSocialCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_keylocker_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeSocialCodeRep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -796,9 +11287,9 @@ func file_proto_keylocker_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_keylocker_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   7,
+			NumMessages:   103,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_keylocker_proto_goTypes,
 		DependencyIndexes: file_proto_keylocker_proto_depIdxs,
@@ -25,6 +25,47 @@ type LeyLockerServiceClient interface {
 	GetSupportChain(ctx context.Context, in *SupportChainReq, opts ...grpc.CallOption) (*SupportChainRep, error)
 	SetSocialKey(ctx context.Context, in *SetSocialKeyReq, opts ...grpc.CallOption) (*SetSocialKeyRep, error)
 	GetSocialKey(ctx context.Context, in *GetSocialKeyReq, opts ...grpc.CallOption) (*GetSocialKeyRep, error)
+	RecoverSocialKey(ctx context.Context, in *RecoverSocialKeyReq, opts ...grpc.CallOption) (*RecoverSocialKeyRep, error)
+	DeleteSocialKey(ctx context.Context, in *DeleteSocialKeyReq, opts ...grpc.CallOption) (*DeleteSocialKeyRep, error)
+	PruneSocialKey(ctx context.Context, in *PruneSocialKeyReq, opts ...grpc.CallOption) (*PruneSocialKeyRep, error)
+	ListSocialKeys(ctx context.Context, in *ListSocialKeysReq, opts ...grpc.CallOption) (*ListSocialKeysRep, error)
+	ReloadConfig(ctx context.Context, in *ReloadConfigReq, opts ...grpc.CallOption) (*ReloadConfigRep, error)
+	ImportSocialKeys(ctx context.Context, opts ...grpc.CallOption) (LeyLockerService_ImportSocialKeysClient, error)
+	GetImportCheckpoint(ctx context.Context, in *GetImportCheckpointReq, opts ...grpc.CallOption) (*GetImportCheckpointRep, error)
+	ExportSocialKeys(ctx context.Context, in *ExportSocialKeysReq, opts ...grpc.CallOption) (LeyLockerService_ExportSocialKeysClient, error)
+	GetJobStatus(ctx context.Context, in *GetJobStatusReq, opts ...grpc.CallOption) (*GetJobStatusRep, error)
+	SetGuardians(ctx context.Context, in *SetGuardiansReq, opts ...grpc.CallOption) (*SetGuardiansRep, error)
+	GetGuardians(ctx context.Context, in *GetGuardiansReq, opts ...grpc.CallOption) (*GetGuardiansRep, error)
+	RequestRecovery(ctx context.Context, in *RequestRecoveryReq, opts ...grpc.CallOption) (*RequestRecoveryRep, error)
+	ApproveRecovery(ctx context.Context, in *ApproveRecoveryReq, opts ...grpc.CallOption) (*ApproveRecoveryRep, error)
+	GetRecoveryCase(ctx context.Context, in *GetRecoveryCaseReq, opts ...grpc.CallOption) (*GetRecoveryCaseRep, error)
+	SetRecoveryDelay(ctx context.Context, in *SetRecoveryDelayReq, opts ...grpc.CallOption) (*SetRecoveryDelayRep, error)
+	CancelRecovery(ctx context.Context, in *CancelRecoveryReq, opts ...grpc.CallOption) (*CancelRecoveryRep, error)
+	SetInheritance(ctx context.Context, in *SetInheritanceReq, opts ...grpc.CallOption) (*SetInheritanceRep, error)
+	Heartbeat(ctx context.Context, in *HeartbeatReq, opts ...grpc.CallOption) (*HeartbeatRep, error)
+	GetInheritance(ctx context.Context, in *GetInheritanceReq, opts ...grpc.CallOption) (*GetInheritanceRep, error)
+	GetInheritedKeys(ctx context.Context, in *GetInheritedKeysReq, opts ...grpc.CallOption) (*GetInheritedKeysRep, error)
+	StoreShares(ctx context.Context, in *StoreSharesReq, opts ...grpc.CallOption) (*StoreSharesRep, error)
+	RefreshShares(ctx context.Context, in *RefreshSharesReq, opts ...grpc.CallOption) (*RefreshSharesRep, error)
+	GetShare(ctx context.Context, in *GetShareReq, opts ...grpc.CallOption) (*GetShareRep, error)
+	ListShares(ctx context.Context, in *ListSharesReq, opts ...grpc.CallOption) (*ListSharesRep, error)
+	SignWithSocialKey(ctx context.Context, in *SignWithSocialKeyReq, opts ...grpc.CallOption) (*SignWithSocialKeyRep, error)
+	DeriveAccounts(ctx context.Context, in *DeriveAccountsReq, opts ...grpc.CallOption) (*DeriveAccountsRep, error)
+	TransferSocialKey(ctx context.Context, in *TransferSocialKeyReq, opts ...grpc.CallOption) (*TransferSocialKeyRep, error)
+	ChangeCredentials(ctx context.Context, in *ChangeCredentialsReq, opts ...grpc.CallOption) (*ChangeCredentialsRep, error)
+	VerifyCredentials(ctx context.Context, in *VerifyCredentialsReq, opts ...grpc.CallOption) (*VerifyCredentialsRep, error)
+	SetOwnerAddress(ctx context.Context, in *SetOwnerAddressReq, opts ...grpc.CallOption) (*SetOwnerAddressRep, error)
+	FreezeWallet(ctx context.Context, in *FreezeWalletReq, opts ...grpc.CallOption) (*FreezeWalletRep, error)
+	UnfreezeWallet(ctx context.Context, in *UnfreezeWalletReq, opts ...grpc.CallOption) (*UnfreezeWalletRep, error)
+	GetChallenge(ctx context.Context, in *GetChallengeReq, opts ...grpc.CallOption) (*GetChallengeRep, error)
+	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginRep, error)
+	EnrollTotp(ctx context.Context, in *EnrollTotpReq, opts ...grpc.CallOption) (*EnrollTotpRep, error)
+	ConfirmTotp(ctx context.Context, in *ConfirmTotpReq, opts ...grpc.CallOption) (*ConfirmTotpRep, error)
+	DisableTotp(ctx context.Context, in *DisableTotpReq, opts ...grpc.CallOption) (*DisableTotpRep, error)
+	GenerateRecoveryCodes(ctx context.Context, in *GenerateRecoveryCodesReq, opts ...grpc.CallOption) (*GenerateRecoveryCodesRep, error)
+	CountRecoveryCodes(ctx context.Context, in *CountRecoveryCodesReq, opts ...grpc.CallOption) (*CountRecoveryCodesRep, error)
+	IssueSocialCode(ctx context.Context, in *IssueSocialCodeReq, opts ...grpc.CallOption) (*IssueSocialCodeRep, error)
+	RevokeSocialCode(ctx context.Context, in *RevokeSocialCodeReq, opts ...grpc.CallOption) (*RevokeSocialCodeRep, error)
 }
 
 type leyLockerServiceClient struct {
@@ -62,113 +103,1873 @@ func (c *leyLockerServiceClient) GetSocialKey(ctx context.Context, in *GetSocial
 	return out, nil
 }
 
+func (c *leyLockerServiceClient) RecoverSocialKey(ctx context.Context, in *RecoverSocialKeyReq, opts ...grpc.CallOption) (*RecoverSocialKeyRep, error) {
+	out := new(RecoverSocialKeyRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/recoverSocialKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) DeleteSocialKey(ctx context.Context, in *DeleteSocialKeyReq, opts ...grpc.CallOption) (*DeleteSocialKeyRep, error) {
+	out := new(DeleteSocialKeyRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/deleteSocialKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) PruneSocialKey(ctx context.Context, in *PruneSocialKeyReq, opts ...grpc.CallOption) (*PruneSocialKeyRep, error) {
+	out := new(PruneSocialKeyRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/pruneSocialKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) ListSocialKeys(ctx context.Context, in *ListSocialKeysReq, opts ...grpc.CallOption) (*ListSocialKeysRep, error) {
+	out := new(ListSocialKeysRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/listSocialKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) ReloadConfig(ctx context.Context, in *ReloadConfigReq, opts ...grpc.CallOption) (*ReloadConfigRep, error) {
+	out := new(ReloadConfigRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/reloadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) ImportSocialKeys(ctx context.Context, opts ...grpc.CallOption) (LeyLockerService_ImportSocialKeysClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LeyLockerService_ServiceDesc.Streams[0], "/savourrpc.keylocker.LeyLockerService/importSocialKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &leyLockerServiceImportSocialKeysClient{stream}
+	return x, nil
+}
+
+type LeyLockerService_ImportSocialKeysClient interface {
+	Send(*ImportSocialKeysReq) error
+	CloseAndRecv() (*ImportSocialKeysRep, error)
+	grpc.ClientStream
+}
+
+type leyLockerServiceImportSocialKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *leyLockerServiceImportSocialKeysClient) Send(m *ImportSocialKeysReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *leyLockerServiceImportSocialKeysClient) CloseAndRecv() (*ImportSocialKeysRep, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportSocialKeysRep)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *leyLockerServiceClient) GetImportCheckpoint(ctx context.Context, in *GetImportCheckpointReq, opts ...grpc.CallOption) (*GetImportCheckpointRep, error) {
+	out := new(GetImportCheckpointRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/getImportCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) ExportSocialKeys(ctx context.Context, in *ExportSocialKeysReq, opts ...grpc.CallOption) (LeyLockerService_ExportSocialKeysClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LeyLockerService_ServiceDesc.Streams[1], "/savourrpc.keylocker.LeyLockerService/exportSocialKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &leyLockerServiceExportSocialKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LeyLockerService_ExportSocialKeysClient interface {
+	Recv() (*ExportSocialKeysRep, error)
+	grpc.ClientStream
+}
+
+type leyLockerServiceExportSocialKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *leyLockerServiceExportSocialKeysClient) Recv() (*ExportSocialKeysRep, error) {
+	m := new(ExportSocialKeysRep)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *leyLockerServiceClient) GetJobStatus(ctx context.Context, in *GetJobStatusReq, opts ...grpc.CallOption) (*GetJobStatusRep, error) {
+	out := new(GetJobStatusRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/getJobStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) SetGuardians(ctx context.Context, in *SetGuardiansReq, opts ...grpc.CallOption) (*SetGuardiansRep, error) {
+	out := new(SetGuardiansRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/setGuardians", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) GetGuardians(ctx context.Context, in *GetGuardiansReq, opts ...grpc.CallOption) (*GetGuardiansRep, error) {
+	out := new(GetGuardiansRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/getGuardians", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) RequestRecovery(ctx context.Context, in *RequestRecoveryReq, opts ...grpc.CallOption) (*RequestRecoveryRep, error) {
+	out := new(RequestRecoveryRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/requestRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) ApproveRecovery(ctx context.Context, in *ApproveRecoveryReq, opts ...grpc.CallOption) (*ApproveRecoveryRep, error) {
+	out := new(ApproveRecoveryRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/approveRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) GetRecoveryCase(ctx context.Context, in *GetRecoveryCaseReq, opts ...grpc.CallOption) (*GetRecoveryCaseRep, error) {
+	out := new(GetRecoveryCaseRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/getRecoveryCase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) SetRecoveryDelay(ctx context.Context, in *SetRecoveryDelayReq, opts ...grpc.CallOption) (*SetRecoveryDelayRep, error) {
+	out := new(SetRecoveryDelayRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/setRecoveryDelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) CancelRecovery(ctx context.Context, in *CancelRecoveryReq, opts ...grpc.CallOption) (*CancelRecoveryRep, error) {
+	out := new(CancelRecoveryRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/cancelRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) SetInheritance(ctx context.Context, in *SetInheritanceReq, opts ...grpc.CallOption) (*SetInheritanceRep, error) {
+	out := new(SetInheritanceRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/setInheritance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) Heartbeat(ctx context.Context, in *HeartbeatReq, opts ...grpc.CallOption) (*HeartbeatRep, error) {
+	out := new(HeartbeatRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) GetInheritance(ctx context.Context, in *GetInheritanceReq, opts ...grpc.CallOption) (*GetInheritanceRep, error) {
+	out := new(GetInheritanceRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/getInheritance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) GetInheritedKeys(ctx context.Context, in *GetInheritedKeysReq, opts ...grpc.CallOption) (*GetInheritedKeysRep, error) {
+	out := new(GetInheritedKeysRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/getInheritedKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) StoreShares(ctx context.Context, in *StoreSharesReq, opts ...grpc.CallOption) (*StoreSharesRep, error) {
+	out := new(StoreSharesRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/storeShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) RefreshShares(ctx context.Context, in *RefreshSharesReq, opts ...grpc.CallOption) (*RefreshSharesRep, error) {
+	out := new(RefreshSharesRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/refreshShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) GetShare(ctx context.Context, in *GetShareReq, opts ...grpc.CallOption) (*GetShareRep, error) {
+	out := new(GetShareRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/getShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) ListShares(ctx context.Context, in *ListSharesReq, opts ...grpc.CallOption) (*ListSharesRep, error) {
+	out := new(ListSharesRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/listShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) SignWithSocialKey(ctx context.Context, in *SignWithSocialKeyReq, opts ...grpc.CallOption) (*SignWithSocialKeyRep, error) {
+	out := new(SignWithSocialKeyRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/signWithSocialKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) DeriveAccounts(ctx context.Context, in *DeriveAccountsReq, opts ...grpc.CallOption) (*DeriveAccountsRep, error) {
+	out := new(DeriveAccountsRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/deriveAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) TransferSocialKey(ctx context.Context, in *TransferSocialKeyReq, opts ...grpc.CallOption) (*TransferSocialKeyRep, error) {
+	out := new(TransferSocialKeyRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/transferSocialKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) ChangeCredentials(ctx context.Context, in *ChangeCredentialsReq, opts ...grpc.CallOption) (*ChangeCredentialsRep, error) {
+	out := new(ChangeCredentialsRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/changeCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) VerifyCredentials(ctx context.Context, in *VerifyCredentialsReq, opts ...grpc.CallOption) (*VerifyCredentialsRep, error) {
+	out := new(VerifyCredentialsRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/verifyCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) SetOwnerAddress(ctx context.Context, in *SetOwnerAddressReq, opts ...grpc.CallOption) (*SetOwnerAddressRep, error) {
+	out := new(SetOwnerAddressRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/setOwnerAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) FreezeWallet(ctx context.Context, in *FreezeWalletReq, opts ...grpc.CallOption) (*FreezeWalletRep, error) {
+	out := new(FreezeWalletRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/freezeWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) UnfreezeWallet(ctx context.Context, in *UnfreezeWalletReq, opts ...grpc.CallOption) (*UnfreezeWalletRep, error) {
+	out := new(UnfreezeWalletRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/unfreezeWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) GetChallenge(ctx context.Context, in *GetChallengeReq, opts ...grpc.CallOption) (*GetChallengeRep, error) {
+	out := new(GetChallengeRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/getChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginRep, error) {
+	out := new(LoginRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) EnrollTotp(ctx context.Context, in *EnrollTotpReq, opts ...grpc.CallOption) (*EnrollTotpRep, error) {
+	out := new(EnrollTotpRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/enrollTotp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) ConfirmTotp(ctx context.Context, in *ConfirmTotpReq, opts ...grpc.CallOption) (*ConfirmTotpRep, error) {
+	out := new(ConfirmTotpRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/confirmTotp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) DisableTotp(ctx context.Context, in *DisableTotpReq, opts ...grpc.CallOption) (*DisableTotpRep, error) {
+	out := new(DisableTotpRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/disableTotp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) GenerateRecoveryCodes(ctx context.Context, in *GenerateRecoveryCodesReq, opts ...grpc.CallOption) (*GenerateRecoveryCodesRep, error) {
+	out := new(GenerateRecoveryCodesRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/generateRecoveryCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) CountRecoveryCodes(ctx context.Context, in *CountRecoveryCodesReq, opts ...grpc.CallOption) (*CountRecoveryCodesRep, error) {
+	out := new(CountRecoveryCodesRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/countRecoveryCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) IssueSocialCode(ctx context.Context, in *IssueSocialCodeReq, opts ...grpc.CallOption) (*IssueSocialCodeRep, error) {
+	out := new(IssueSocialCodeRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/issueSocialCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leyLockerServiceClient) RevokeSocialCode(ctx context.Context, in *RevokeSocialCodeReq, opts ...grpc.CallOption) (*RevokeSocialCodeRep, error) {
+	out := new(RevokeSocialCodeRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.LeyLockerService/revokeSocialCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LeyLockerServiceServer is the server API for LeyLockerService service.
-// All implementations must embed UnimplementedLeyLockerServiceServer
+// All implementations should embed UnimplementedLeyLockerServiceServer
+// for forward compatibility
+type LeyLockerServiceServer interface {
+	GetSupportChain(context.Context, *SupportChainReq) (*SupportChainRep, error)
+	SetSocialKey(context.Context, *SetSocialKeyReq) (*SetSocialKeyRep, error)
+	GetSocialKey(context.Context, *GetSocialKeyReq) (*GetSocialKeyRep, error)
+	RecoverSocialKey(context.Context, *RecoverSocialKeyReq) (*RecoverSocialKeyRep, error)
+	DeleteSocialKey(context.Context, *DeleteSocialKeyReq) (*DeleteSocialKeyRep, error)
+	PruneSocialKey(context.Context, *PruneSocialKeyReq) (*PruneSocialKeyRep, error)
+	ListSocialKeys(context.Context, *ListSocialKeysReq) (*ListSocialKeysRep, error)
+	ReloadConfig(context.Context, *ReloadConfigReq) (*ReloadConfigRep, error)
+	ImportSocialKeys(LeyLockerService_ImportSocialKeysServer) error
+	GetImportCheckpoint(context.Context, *GetImportCheckpointReq) (*GetImportCheckpointRep, error)
+	ExportSocialKeys(*ExportSocialKeysReq, LeyLockerService_ExportSocialKeysServer) error
+	GetJobStatus(context.Context, *GetJobStatusReq) (*GetJobStatusRep, error)
+	SetGuardians(context.Context, *SetGuardiansReq) (*SetGuardiansRep, error)
+	GetGuardians(context.Context, *GetGuardiansReq) (*GetGuardiansRep, error)
+	RequestRecovery(context.Context, *RequestRecoveryReq) (*RequestRecoveryRep, error)
+	ApproveRecovery(context.Context, *ApproveRecoveryReq) (*ApproveRecoveryRep, error)
+	GetRecoveryCase(context.Context, *GetRecoveryCaseReq) (*GetRecoveryCaseRep, error)
+	SetRecoveryDelay(context.Context, *SetRecoveryDelayReq) (*SetRecoveryDelayRep, error)
+	CancelRecovery(context.Context, *CancelRecoveryReq) (*CancelRecoveryRep, error)
+	SetInheritance(context.Context, *SetInheritanceReq) (*SetInheritanceRep, error)
+	Heartbeat(context.Context, *HeartbeatReq) (*HeartbeatRep, error)
+	GetInheritance(context.Context, *GetInheritanceReq) (*GetInheritanceRep, error)
+	GetInheritedKeys(context.Context, *GetInheritedKeysReq) (*GetInheritedKeysRep, error)
+	StoreShares(context.Context, *StoreSharesReq) (*StoreSharesRep, error)
+	RefreshShares(context.Context, *RefreshSharesReq) (*RefreshSharesRep, error)
+	GetShare(context.Context, *GetShareReq) (*GetShareRep, error)
+	ListShares(context.Context, *ListSharesReq) (*ListSharesRep, error)
+	SignWithSocialKey(context.Context, *SignWithSocialKeyReq) (*SignWithSocialKeyRep, error)
+	DeriveAccounts(context.Context, *DeriveAccountsReq) (*DeriveAccountsRep, error)
+	TransferSocialKey(context.Context, *TransferSocialKeyReq) (*TransferSocialKeyRep, error)
+	ChangeCredentials(context.Context, *ChangeCredentialsReq) (*ChangeCredentialsRep, error)
+	VerifyCredentials(context.Context, *VerifyCredentialsReq) (*VerifyCredentialsRep, error)
+	SetOwnerAddress(context.Context, *SetOwnerAddressReq) (*SetOwnerAddressRep, error)
+	FreezeWallet(context.Context, *FreezeWalletReq) (*FreezeWalletRep, error)
+	UnfreezeWallet(context.Context, *UnfreezeWalletReq) (*UnfreezeWalletRep, error)
+	GetChallenge(context.Context, *GetChallengeReq) (*GetChallengeRep, error)
+	Login(context.Context, *LoginReq) (*LoginRep, error)
+	EnrollTotp(context.Context, *EnrollTotpReq) (*EnrollTotpRep, error)
+	ConfirmTotp(context.Context, *ConfirmTotpReq) (*ConfirmTotpRep, error)
+	DisableTotp(context.Context, *DisableTotpReq) (*DisableTotpRep, error)
+	GenerateRecoveryCodes(context.Context, *GenerateRecoveryCodesReq) (*GenerateRecoveryCodesRep, error)
+	CountRecoveryCodes(context.Context, *CountRecoveryCodesReq) (*CountRecoveryCodesRep, error)
+	IssueSocialCode(context.Context, *IssueSocialCodeReq) (*IssueSocialCodeRep, error)
+	RevokeSocialCode(context.Context, *RevokeSocialCodeReq) (*RevokeSocialCodeRep, error)
+}
+
+// UnimplementedLeyLockerServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedLeyLockerServiceServer struct {
+}
+
+func (UnimplementedLeyLockerServiceServer) GetSupportChain(context.Context, *SupportChainReq) (*SupportChainRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSupportChain not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) SetSocialKey(context.Context, *SetSocialKeyReq) (*SetSocialKeyRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSocialKey not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) GetSocialKey(context.Context, *GetSocialKeyReq) (*GetSocialKeyRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSocialKey not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) RecoverSocialKey(context.Context, *RecoverSocialKeyReq) (*RecoverSocialKeyRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverSocialKey not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) DeleteSocialKey(context.Context, *DeleteSocialKeyReq) (*DeleteSocialKeyRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSocialKey not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) PruneSocialKey(context.Context, *PruneSocialKeyReq) (*PruneSocialKeyRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneSocialKey not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) ListSocialKeys(context.Context, *ListSocialKeysReq) (*ListSocialKeysRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSocialKeys not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) ReloadConfig(context.Context, *ReloadConfigReq) (*ReloadConfigRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) ImportSocialKeys(LeyLockerService_ImportSocialKeysServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportSocialKeys not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) GetImportCheckpoint(context.Context, *GetImportCheckpointReq) (*GetImportCheckpointRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportCheckpoint not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) ExportSocialKeys(*ExportSocialKeysReq, LeyLockerService_ExportSocialKeysServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSocialKeys not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) GetJobStatus(context.Context, *GetJobStatusReq) (*GetJobStatusRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobStatus not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) SetGuardians(context.Context, *SetGuardiansReq) (*SetGuardiansRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGuardians not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) GetGuardians(context.Context, *GetGuardiansReq) (*GetGuardiansRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuardians not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) RequestRecovery(context.Context, *RequestRecoveryReq) (*RequestRecoveryRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRecovery not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) ApproveRecovery(context.Context, *ApproveRecoveryReq) (*ApproveRecoveryRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRecovery not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) GetRecoveryCase(context.Context, *GetRecoveryCaseReq) (*GetRecoveryCaseRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveryCase not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) SetRecoveryDelay(context.Context, *SetRecoveryDelayReq) (*SetRecoveryDelayRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRecoveryDelay not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) CancelRecovery(context.Context, *CancelRecoveryReq) (*CancelRecoveryRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecovery not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) SetInheritance(context.Context, *SetInheritanceReq) (*SetInheritanceRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInheritance not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) Heartbeat(context.Context, *HeartbeatReq) (*HeartbeatRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) GetInheritance(context.Context, *GetInheritanceReq) (*GetInheritanceRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInheritance not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) GetInheritedKeys(context.Context, *GetInheritedKeysReq) (*GetInheritedKeysRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInheritedKeys not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) StoreShares(context.Context, *StoreSharesReq) (*StoreSharesRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreShares not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) RefreshShares(context.Context, *RefreshSharesReq) (*RefreshSharesRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshShares not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) GetShare(context.Context, *GetShareReq) (*GetShareRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShare not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) ListShares(context.Context, *ListSharesReq) (*ListSharesRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShares not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) SignWithSocialKey(context.Context, *SignWithSocialKeyReq) (*SignWithSocialKeyRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignWithSocialKey not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) DeriveAccounts(context.Context, *DeriveAccountsReq) (*DeriveAccountsRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeriveAccounts not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) TransferSocialKey(context.Context, *TransferSocialKeyReq) (*TransferSocialKeyRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferSocialKey not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) ChangeCredentials(context.Context, *ChangeCredentialsReq) (*ChangeCredentialsRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeCredentials not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) VerifyCredentials(context.Context, *VerifyCredentialsReq) (*VerifyCredentialsRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredentials not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) SetOwnerAddress(context.Context, *SetOwnerAddressReq) (*SetOwnerAddressRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOwnerAddress not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) FreezeWallet(context.Context, *FreezeWalletReq) (*FreezeWalletRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeWallet not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) UnfreezeWallet(context.Context, *UnfreezeWalletReq) (*UnfreezeWalletRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeWallet not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) GetChallenge(context.Context, *GetChallengeReq) (*GetChallengeRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChallenge not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) Login(context.Context, *LoginReq) (*LoginRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) EnrollTotp(context.Context, *EnrollTotpReq) (*EnrollTotpRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTotp not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) ConfirmTotp(context.Context, *ConfirmTotpReq) (*ConfirmTotpRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTotp not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) DisableTotp(context.Context, *DisableTotpReq) (*DisableTotpRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTotp not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) GenerateRecoveryCodes(context.Context, *GenerateRecoveryCodesReq) (*GenerateRecoveryCodesRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateRecoveryCodes not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) CountRecoveryCodes(context.Context, *CountRecoveryCodesReq) (*CountRecoveryCodesRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountRecoveryCodes not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) IssueSocialCode(context.Context, *IssueSocialCodeReq) (*IssueSocialCodeRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueSocialCode not implemented")
+}
+func (UnimplementedLeyLockerServiceServer) RevokeSocialCode(context.Context, *RevokeSocialCodeReq) (*RevokeSocialCodeRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSocialCode not implemented")
+}
+
+// UnsafeLeyLockerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LeyLockerServiceServer will
+// result in compilation errors.
+type UnsafeLeyLockerServiceServer interface {
+	mustEmbedUnimplementedLeyLockerServiceServer()
+}
+
+func RegisterLeyLockerServiceServer(s grpc.ServiceRegistrar, srv LeyLockerServiceServer) {
+	s.RegisterService(&LeyLockerService_ServiceDesc, srv)
+}
+
+func _LeyLockerService_GetSupportChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupportChainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).GetSupportChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/getSupportChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).GetSupportChain(ctx, req.(*SupportChainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_SetSocialKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSocialKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).SetSocialKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/setSocialKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).SetSocialKey(ctx, req.(*SetSocialKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_GetSocialKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSocialKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).GetSocialKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/getSocialKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).GetSocialKey(ctx, req.(*GetSocialKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_RecoverSocialKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverSocialKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).RecoverSocialKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/recoverSocialKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).RecoverSocialKey(ctx, req.(*RecoverSocialKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_DeleteSocialKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSocialKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).DeleteSocialKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/deleteSocialKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).DeleteSocialKey(ctx, req.(*DeleteSocialKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_PruneSocialKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneSocialKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).PruneSocialKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/pruneSocialKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).PruneSocialKey(ctx, req.(*PruneSocialKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_ListSocialKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSocialKeysReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).ListSocialKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/listSocialKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).ListSocialKeys(ctx, req.(*ListSocialKeysReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/reloadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).ReloadConfig(ctx, req.(*ReloadConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_ImportSocialKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LeyLockerServiceServer).ImportSocialKeys(&leyLockerServiceImportSocialKeysServer{stream})
+}
+
+type LeyLockerService_ImportSocialKeysServer interface {
+	SendAndClose(*ImportSocialKeysRep) error
+	Recv() (*ImportSocialKeysReq, error)
+	grpc.ServerStream
+}
+
+type leyLockerServiceImportSocialKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *leyLockerServiceImportSocialKeysServer) SendAndClose(m *ImportSocialKeysRep) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *leyLockerServiceImportSocialKeysServer) Recv() (*ImportSocialKeysReq, error) {
+	m := new(ImportSocialKeysReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _LeyLockerService_GetImportCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportCheckpointReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).GetImportCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/getImportCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).GetImportCheckpoint(ctx, req.(*GetImportCheckpointReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_ExportSocialKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSocialKeysReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LeyLockerServiceServer).ExportSocialKeys(m, &leyLockerServiceExportSocialKeysServer{stream})
+}
+
+type LeyLockerService_ExportSocialKeysServer interface {
+	Send(*ExportSocialKeysRep) error
+	grpc.ServerStream
+}
+
+type leyLockerServiceExportSocialKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *leyLockerServiceExportSocialKeysServer) Send(m *ExportSocialKeysRep) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LeyLockerService_GetJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).GetJobStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/getJobStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).GetJobStatus(ctx, req.(*GetJobStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_SetGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGuardiansReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).SetGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/setGuardians",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).SetGuardians(ctx, req.(*SetGuardiansReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_GetGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuardiansReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).GetGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/getGuardians",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).GetGuardians(ctx, req.(*GetGuardiansReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_RequestRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestRecoveryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).RequestRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/requestRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).RequestRecovery(ctx, req.(*RequestRecoveryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_ApproveRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRecoveryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).ApproveRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/approveRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).ApproveRecovery(ctx, req.(*ApproveRecoveryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_GetRecoveryCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecoveryCaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).GetRecoveryCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/getRecoveryCase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).GetRecoveryCase(ctx, req.(*GetRecoveryCaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_SetRecoveryDelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRecoveryDelayReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).SetRecoveryDelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/setRecoveryDelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).SetRecoveryDelay(ctx, req.(*SetRecoveryDelayReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_CancelRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRecoveryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).CancelRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/cancelRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).CancelRecovery(ctx, req.(*CancelRecoveryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_SetInheritance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInheritanceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).SetInheritance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/setInheritance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).SetInheritance(ctx, req.(*SetInheritanceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).Heartbeat(ctx, req.(*HeartbeatReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_GetInheritance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInheritanceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).GetInheritance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/getInheritance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).GetInheritance(ctx, req.(*GetInheritanceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_GetInheritedKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInheritedKeysReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).GetInheritedKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/getInheritedKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).GetInheritedKeys(ctx, req.(*GetInheritedKeysReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_StoreShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreSharesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).StoreShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/storeShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).StoreShares(ctx, req.(*StoreSharesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_RefreshShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshSharesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).RefreshShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/refreshShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).RefreshShares(ctx, req.(*RefreshSharesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_GetShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShareReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).GetShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/getShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).GetShare(ctx, req.(*GetShareReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_ListShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).ListShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/listShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).ListShares(ctx, req.(*ListSharesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_SignWithSocialKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignWithSocialKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).SignWithSocialKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/signWithSocialKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).SignWithSocialKey(ctx, req.(*SignWithSocialKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_DeriveAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeriveAccountsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).DeriveAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/deriveAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).DeriveAccounts(ctx, req.(*DeriveAccountsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_TransferSocialKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferSocialKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).TransferSocialKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/transferSocialKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).TransferSocialKey(ctx, req.(*TransferSocialKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_ChangeCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeCredentialsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).ChangeCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/changeCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).ChangeCredentials(ctx, req.(*ChangeCredentialsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_VerifyCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCredentialsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).VerifyCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/verifyCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).VerifyCredentials(ctx, req.(*VerifyCredentialsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_SetOwnerAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOwnerAddressReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).SetOwnerAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/setOwnerAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).SetOwnerAddress(ctx, req.(*SetOwnerAddressReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_FreezeWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeWalletReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).FreezeWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/freezeWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).FreezeWallet(ctx, req.(*FreezeWalletReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_UnfreezeWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeWalletReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).UnfreezeWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/unfreezeWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).UnfreezeWallet(ctx, req.(*UnfreezeWalletReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_GetChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChallengeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).GetChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/getChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).GetChallenge(ctx, req.(*GetChallengeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).Login(ctx, req.(*LoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_EnrollTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTotpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).EnrollTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/enrollTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).EnrollTotp(ctx, req.(*EnrollTotpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_ConfirmTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTotpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).ConfirmTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/confirmTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).ConfirmTotp(ctx, req.(*ConfirmTotpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_DisableTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTotpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).DisableTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/disableTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).DisableTotp(ctx, req.(*DisableTotpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_GenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRecoveryCodesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).GenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/generateRecoveryCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).GenerateRecoveryCodes(ctx, req.(*GenerateRecoveryCodesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_CountRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountRecoveryCodesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).CountRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/countRecoveryCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).CountRecoveryCodes(ctx, req.(*CountRecoveryCodesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_IssueSocialCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueSocialCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).IssueSocialCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/issueSocialCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).IssueSocialCode(ctx, req.(*IssueSocialCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeyLockerService_RevokeSocialCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSocialCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeyLockerServiceServer).RevokeSocialCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.LeyLockerService/revokeSocialCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeyLockerServiceServer).RevokeSocialCode(ctx, req.(*RevokeSocialCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LeyLockerService_ServiceDesc is the grpc.ServiceDesc for LeyLockerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LeyLockerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "savourrpc.keylocker.LeyLockerService",
+	HandlerType: (*LeyLockerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "getSupportChain",
+			Handler:    _LeyLockerService_GetSupportChain_Handler,
+		},
+		{
+			MethodName: "setSocialKey",
+			Handler:    _LeyLockerService_SetSocialKey_Handler,
+		},
+		{
+			MethodName: "getSocialKey",
+			Handler:    _LeyLockerService_GetSocialKey_Handler,
+		},
+		{
+			MethodName: "recoverSocialKey",
+			Handler:    _LeyLockerService_RecoverSocialKey_Handler,
+		},
+		{
+			MethodName: "deleteSocialKey",
+			Handler:    _LeyLockerService_DeleteSocialKey_Handler,
+		},
+		{
+			MethodName: "pruneSocialKey",
+			Handler:    _LeyLockerService_PruneSocialKey_Handler,
+		},
+		{
+			MethodName: "listSocialKeys",
+			Handler:    _LeyLockerService_ListSocialKeys_Handler,
+		},
+		{
+			MethodName: "reloadConfig",
+			Handler:    _LeyLockerService_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "getImportCheckpoint",
+			Handler:    _LeyLockerService_GetImportCheckpoint_Handler,
+		},
+		{
+			MethodName: "getJobStatus",
+			Handler:    _LeyLockerService_GetJobStatus_Handler,
+		},
+		{
+			MethodName: "setGuardians",
+			Handler:    _LeyLockerService_SetGuardians_Handler,
+		},
+		{
+			MethodName: "getGuardians",
+			Handler:    _LeyLockerService_GetGuardians_Handler,
+		},
+		{
+			MethodName: "requestRecovery",
+			Handler:    _LeyLockerService_RequestRecovery_Handler,
+		},
+		{
+			MethodName: "approveRecovery",
+			Handler:    _LeyLockerService_ApproveRecovery_Handler,
+		},
+		{
+			MethodName: "getRecoveryCase",
+			Handler:    _LeyLockerService_GetRecoveryCase_Handler,
+		},
+		{
+			MethodName: "setRecoveryDelay",
+			Handler:    _LeyLockerService_SetRecoveryDelay_Handler,
+		},
+		{
+			MethodName: "cancelRecovery",
+			Handler:    _LeyLockerService_CancelRecovery_Handler,
+		},
+		{
+			MethodName: "setInheritance",
+			Handler:    _LeyLockerService_SetInheritance_Handler,
+		},
+		{
+			MethodName: "heartbeat",
+			Handler:    _LeyLockerService_Heartbeat_Handler,
+		},
+		{
+			MethodName: "getInheritance",
+			Handler:    _LeyLockerService_GetInheritance_Handler,
+		},
+		{
+			MethodName: "getInheritedKeys",
+			Handler:    _LeyLockerService_GetInheritedKeys_Handler,
+		},
+		{
+			MethodName: "storeShares",
+			Handler:    _LeyLockerService_StoreShares_Handler,
+		},
+		{
+			MethodName: "refreshShares",
+			Handler:    _LeyLockerService_RefreshShares_Handler,
+		},
+		{
+			MethodName: "getShare",
+			Handler:    _LeyLockerService_GetShare_Handler,
+		},
+		{
+			MethodName: "listShares",
+			Handler:    _LeyLockerService_ListShares_Handler,
+		},
+		{
+			MethodName: "signWithSocialKey",
+			Handler:    _LeyLockerService_SignWithSocialKey_Handler,
+		},
+		{
+			MethodName: "deriveAccounts",
+			Handler:    _LeyLockerService_DeriveAccounts_Handler,
+		},
+		{
+			MethodName: "transferSocialKey",
+			Handler:    _LeyLockerService_TransferSocialKey_Handler,
+		},
+		{
+			MethodName: "changeCredentials",
+			Handler:    _LeyLockerService_ChangeCredentials_Handler,
+		},
+		{
+			MethodName: "verifyCredentials",
+			Handler:    _LeyLockerService_VerifyCredentials_Handler,
+		},
+		{
+			MethodName: "setOwnerAddress",
+			Handler:    _LeyLockerService_SetOwnerAddress_Handler,
+		},
+		{
+			MethodName: "freezeWallet",
+			Handler:    _LeyLockerService_FreezeWallet_Handler,
+		},
+		{
+			MethodName: "unfreezeWallet",
+			Handler:    _LeyLockerService_UnfreezeWallet_Handler,
+		},
+		{
+			MethodName: "getChallenge",
+			Handler:    _LeyLockerService_GetChallenge_Handler,
+		},
+		{
+			MethodName: "login",
+			Handler:    _LeyLockerService_Login_Handler,
+		},
+		{
+			MethodName: "enrollTotp",
+			Handler:    _LeyLockerService_EnrollTotp_Handler,
+		},
+		{
+			MethodName: "confirmTotp",
+			Handler:    _LeyLockerService_ConfirmTotp_Handler,
+		},
+		{
+			MethodName: "disableTotp",
+			Handler:    _LeyLockerService_DisableTotp_Handler,
+		},
+		{
+			MethodName: "generateRecoveryCodes",
+			Handler:    _LeyLockerService_GenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "countRecoveryCodes",
+			Handler:    _LeyLockerService_CountRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "issueSocialCode",
+			Handler:    _LeyLockerService_IssueSocialCode_Handler,
+		},
+		{
+			MethodName: "revokeSocialCode",
+			Handler:    _LeyLockerService_RevokeSocialCode_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "importSocialKeys",
+			Handler:       _LeyLockerService_ImportSocialKeys_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "exportSocialKeys",
+			Handler:       _LeyLockerService_ExportSocialKeys_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/keylocker.proto",
+}
+
+// KeyAdaptorPluginClient is the client API for KeyAdaptorPlugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type KeyAdaptorPluginClient interface {
+	Describe(ctx context.Context, in *PluginDescribeReq, opts ...grpc.CallOption) (*PluginDescribeRep, error)
+	GetSupportChain(ctx context.Context, in *SupportChainReq, opts ...grpc.CallOption) (*SupportChainRep, error)
+	SetSocialKey(ctx context.Context, in *SetSocialKeyReq, opts ...grpc.CallOption) (*SetSocialKeyRep, error)
+	GetSocialKey(ctx context.Context, in *GetSocialKeyReq, opts ...grpc.CallOption) (*GetSocialKeyRep, error)
+	DeleteSocialKey(ctx context.Context, in *DeleteSocialKeyReq, opts ...grpc.CallOption) (*DeleteSocialKeyRep, error)
+	PruneSocialKey(ctx context.Context, in *PruneSocialKeyReq, opts ...grpc.CallOption) (*PruneSocialKeyRep, error)
+}
+
+type keyAdaptorPluginClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKeyAdaptorPluginClient(cc grpc.ClientConnInterface) KeyAdaptorPluginClient {
+	return &keyAdaptorPluginClient{cc}
+}
+
+func (c *keyAdaptorPluginClient) Describe(ctx context.Context, in *PluginDescribeReq, opts ...grpc.CallOption) (*PluginDescribeRep, error) {
+	out := new(PluginDescribeRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.KeyAdaptorPlugin/describe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyAdaptorPluginClient) GetSupportChain(ctx context.Context, in *SupportChainReq, opts ...grpc.CallOption) (*SupportChainRep, error) {
+	out := new(SupportChainRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.KeyAdaptorPlugin/getSupportChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyAdaptorPluginClient) SetSocialKey(ctx context.Context, in *SetSocialKeyReq, opts ...grpc.CallOption) (*SetSocialKeyRep, error) {
+	out := new(SetSocialKeyRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.KeyAdaptorPlugin/setSocialKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyAdaptorPluginClient) GetSocialKey(ctx context.Context, in *GetSocialKeyReq, opts ...grpc.CallOption) (*GetSocialKeyRep, error) {
+	out := new(GetSocialKeyRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.KeyAdaptorPlugin/getSocialKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyAdaptorPluginClient) DeleteSocialKey(ctx context.Context, in *DeleteSocialKeyReq, opts ...grpc.CallOption) (*DeleteSocialKeyRep, error) {
+	out := new(DeleteSocialKeyRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.KeyAdaptorPlugin/deleteSocialKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyAdaptorPluginClient) PruneSocialKey(ctx context.Context, in *PruneSocialKeyReq, opts ...grpc.CallOption) (*PruneSocialKeyRep, error) {
+	out := new(PruneSocialKeyRep)
+	err := c.cc.Invoke(ctx, "/savourrpc.keylocker.KeyAdaptorPlugin/pruneSocialKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KeyAdaptorPluginServer is the server API for KeyAdaptorPlugin service.
+// All implementations should embed UnimplementedKeyAdaptorPluginServer
 // for forward compatibility
-type LeyLockerServiceServer interface {
+type KeyAdaptorPluginServer interface {
+	Describe(context.Context, *PluginDescribeReq) (*PluginDescribeRep, error)
 	GetSupportChain(context.Context, *SupportChainReq) (*SupportChainRep, error)
 	SetSocialKey(context.Context, *SetSocialKeyReq) (*SetSocialKeyRep, error)
 	GetSocialKey(context.Context, *GetSocialKeyReq) (*GetSocialKeyRep, error)
+	DeleteSocialKey(context.Context, *DeleteSocialKeyReq) (*DeleteSocialKeyRep, error)
+	PruneSocialKey(context.Context, *PruneSocialKeyReq) (*PruneSocialKeyRep, error)
 }
 
-// UnimplementedLeyLockerServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedLeyLockerServiceServer struct {
+// UnimplementedKeyAdaptorPluginServer should be embedded to have forward compatible implementations.
+type UnimplementedKeyAdaptorPluginServer struct {
 }
 
-func (UnimplementedLeyLockerServiceServer) GetSupportChain(context.Context, *SupportChainReq) (*SupportChainRep, error) {
+func (UnimplementedKeyAdaptorPluginServer) Describe(context.Context, *PluginDescribeReq) (*PluginDescribeRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Describe not implemented")
+}
+func (UnimplementedKeyAdaptorPluginServer) GetSupportChain(context.Context, *SupportChainReq) (*SupportChainRep, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSupportChain not implemented")
 }
-func (UnimplementedLeyLockerServiceServer) SetSocialKey(context.Context, *SetSocialKeyReq) (*SetSocialKeyRep, error) {
+func (UnimplementedKeyAdaptorPluginServer) SetSocialKey(context.Context, *SetSocialKeyReq) (*SetSocialKeyRep, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSocialKey not implemented")
 }
-func (UnimplementedLeyLockerServiceServer) GetSocialKey(context.Context, *GetSocialKeyReq) (*GetSocialKeyRep, error) {
+func (UnimplementedKeyAdaptorPluginServer) GetSocialKey(context.Context, *GetSocialKeyReq) (*GetSocialKeyRep, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSocialKey not implemented")
 }
-func (UnimplementedLeyLockerServiceServer) mustEmbedUnimplementedLeyLockerServiceServer() {}
+func (UnimplementedKeyAdaptorPluginServer) DeleteSocialKey(context.Context, *DeleteSocialKeyReq) (*DeleteSocialKeyRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSocialKey not implemented")
+}
+func (UnimplementedKeyAdaptorPluginServer) PruneSocialKey(context.Context, *PruneSocialKeyReq) (*PruneSocialKeyRep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneSocialKey not implemented")
+}
 
-// UnsafeLeyLockerServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to LeyLockerServiceServer will
+// UnsafeKeyAdaptorPluginServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KeyAdaptorPluginServer will
 // result in compilation errors.
-type UnsafeLeyLockerServiceServer interface {
-	mustEmbedUnimplementedLeyLockerServiceServer()
+type UnsafeKeyAdaptorPluginServer interface {
+	mustEmbedUnimplementedKeyAdaptorPluginServer()
 }
 
-func RegisterLeyLockerServiceServer(s grpc.ServiceRegistrar, srv LeyLockerServiceServer) {
-	s.RegisterService(&LeyLockerService_ServiceDesc, srv)
+func RegisterKeyAdaptorPluginServer(s grpc.ServiceRegistrar, srv KeyAdaptorPluginServer) {
+	s.RegisterService(&KeyAdaptorPlugin_ServiceDesc, srv)
 }
 
-func _LeyLockerService_GetSupportChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _KeyAdaptorPlugin_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginDescribeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyAdaptorPluginServer).Describe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.KeyAdaptorPlugin/describe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyAdaptorPluginServer).Describe(ctx, req.(*PluginDescribeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyAdaptorPlugin_GetSupportChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SupportChainReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LeyLockerServiceServer).GetSupportChain(ctx, in)
+		return srv.(KeyAdaptorPluginServer).GetSupportChain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/savourrpc.keylocker.LeyLockerService/getSupportChain",
+		FullMethod: "/savourrpc.keylocker.KeyAdaptorPlugin/getSupportChain",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeyLockerServiceServer).GetSupportChain(ctx, req.(*SupportChainReq))
+		return srv.(KeyAdaptorPluginServer).GetSupportChain(ctx, req.(*SupportChainReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LeyLockerService_SetSocialKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _KeyAdaptorPlugin_SetSocialKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetSocialKeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LeyLockerServiceServer).SetSocialKey(ctx, in)
+		return srv.(KeyAdaptorPluginServer).SetSocialKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/savourrpc.keylocker.LeyLockerService/setSocialKey",
+		FullMethod: "/savourrpc.keylocker.KeyAdaptorPlugin/setSocialKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeyLockerServiceServer).SetSocialKey(ctx, req.(*SetSocialKeyReq))
+		return srv.(KeyAdaptorPluginServer).SetSocialKey(ctx, req.(*SetSocialKeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LeyLockerService_GetSocialKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _KeyAdaptorPlugin_GetSocialKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSocialKeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LeyLockerServiceServer).GetSocialKey(ctx, in)
+		return srv.(KeyAdaptorPluginServer).GetSocialKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/savourrpc.keylocker.LeyLockerService/getSocialKey",
+		FullMethod: "/savourrpc.keylocker.KeyAdaptorPlugin/getSocialKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeyLockerServiceServer).GetSocialKey(ctx, req.(*GetSocialKeyReq))
+		return srv.(KeyAdaptorPluginServer).GetSocialKey(ctx, req.(*GetSocialKeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// LeyLockerService_ServiceDesc is the grpc.ServiceDesc for LeyLockerService service.
+func _KeyAdaptorPlugin_DeleteSocialKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSocialKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyAdaptorPluginServer).DeleteSocialKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.KeyAdaptorPlugin/deleteSocialKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyAdaptorPluginServer).DeleteSocialKey(ctx, req.(*DeleteSocialKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyAdaptorPlugin_PruneSocialKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneSocialKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyAdaptorPluginServer).PruneSocialKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/savourrpc.keylocker.KeyAdaptorPlugin/pruneSocialKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyAdaptorPluginServer).PruneSocialKey(ctx, req.(*PruneSocialKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KeyAdaptorPlugin_ServiceDesc is the grpc.ServiceDesc for KeyAdaptorPlugin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var LeyLockerService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "savourrpc.keylocker.LeyLockerService",
-	HandlerType: (*LeyLockerServiceServer)(nil),
+var KeyAdaptorPlugin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "savourrpc.keylocker.KeyAdaptorPlugin",
+	HandlerType: (*KeyAdaptorPluginServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "describe",
+			Handler:    _KeyAdaptorPlugin_Describe_Handler,
+		},
 		{
 			MethodName: "getSupportChain",
-			Handler:    _LeyLockerService_GetSupportChain_Handler,
+			Handler:    _KeyAdaptorPlugin_GetSupportChain_Handler,
 		},
 		{
 			MethodName: "setSocialKey",
-			Handler:    _LeyLockerService_SetSocialKey_Handler,
+			Handler:    _KeyAdaptorPlugin_SetSocialKey_Handler,
 		},
 		{
 			MethodName: "getSocialKey",
-			Handler:    _LeyLockerService_GetSocialKey_Handler,
+			Handler:    _KeyAdaptorPlugin_GetSocialKey_Handler,
+		},
+		{
+			MethodName: "deleteSocialKey",
+			Handler:    _KeyAdaptorPlugin_DeleteSocialKey_Handler,
+		},
+		{
+			MethodName: "pruneSocialKey",
+			Handler:    _KeyAdaptorPlugin_PruneSocialKey_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},